@@ -1,10 +1,15 @@
 package linode
 
 import (
+	"bufio"
+	"bytes"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"net/http"
+	"net/http/httputil"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -20,21 +25,32 @@ const uaEnvVar = "TF_APPEND_USER_AGENT"
 // DefaultLinodeURL is the Linode APIv4 URL to use.
 const DefaultLinodeURL = "https://api.linode.com/v4"
 
+// DefaultConfigPath is the location of the Linode config file used when no token is given.
+const DefaultConfigPath = "~/.config/linode"
+
+// DefaultConfigProfile is the config file profile used when no profile is given.
+const DefaultConfigProfile = "default"
+
 // Config represents the Linode provider configuration.
 type Config struct {
 	AccessToken string
 	APIURL      string
 	APIVersion  string
 	UAPrefix    string
+	Debug       bool
 
 	terraformVersion string
 
 	SkipInstanceReadyPoll        bool
+	SkipVolumeReadyPoll          bool
 	MinRetryDelayMilliseconds    int
 	MaxRetryDelayMilliseconds    int
 	EventPollMilliseconds        int
 	LKEEventPollMilliseconds     int
+	VolumeEventPollMilliseconds  int
 	LKENodeReadyPollMilliseconds int
+
+	ListPageSize int
 }
 
 // Client returns a fully initialized Linode client.
@@ -43,6 +59,9 @@ func (c *Config) Client() linodego.Client {
 	oauthTransport := &oauth2.Transport{
 		Source: tokenSource,
 	}
+	if c.Debug {
+		oauthTransport.Base = &debugTransport{transport: http.DefaultTransport}
+	}
 	loggingTransport := logging.NewTransport("Linode", oauthTransport)
 
 	oauth2Client := &http.Client{
@@ -79,6 +98,49 @@ func (c *Config) Client() linodego.Client {
 	return client
 }
 
+// debugTransport logs raw API requests and responses with the Authorization header redacted.
+type debugTransport struct {
+	transport http.RoundTripper
+}
+
+func (t *debugTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Clone only copies the body reference, so the body is buffered to be both dumped and sent.
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		if body, err = ioutil.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+	}
+
+	redacted := req.Clone(req.Context())
+	if redacted.Header.Get("Authorization") != "" {
+		redacted.Header.Set("Authorization", "[REDACTED]")
+	}
+	if body != nil {
+		redacted.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	if dump, err := httputil.DumpRequestOut(redacted, true); err == nil {
+		log.Printf("[DEBUG] Linode API Request:\n%s", dump)
+	}
+
+	sent := req.Clone(req.Context())
+	if body != nil {
+		sent.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	resp, err := t.transport.RoundTrip(sent)
+	if err != nil {
+		return resp, err
+	}
+
+	if dump, err := httputil.DumpResponse(resp, true); err == nil {
+		log.Printf("[DEBUG] Linode API Response:\n%s", dump)
+	}
+	return resp, nil
+}
+
 func terraformUserAgent(version string) string {
 	ua := fmt.Sprintf("HashiCorp Terraform/%s (+https://www.terraform.io) Terraform Plugin SDK/%s",
 		version, meta.SDKVersionString())
@@ -93,3 +155,71 @@ func terraformUserAgent(version string) string {
 
 	return ua
 }
+
+// ConfigProfile represents a named profile of a Linode config file.
+type ConfigProfile struct {
+	Token      string
+	APIURL     string
+	APIVersion string
+}
+
+// loadConfigProfile reads the named profile from an INI-style Linode config file. If profile is empty, the
+// default-profile key of the default section is honored before falling back to the default section itself.
+func loadConfigProfile(path, profile string) (*ConfigProfile, error) {
+	if strings.HasPrefix(path, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("Error resolving home directory for config path %s: %s", path, err)
+		}
+		path = filepath.Join(home, path[2:])
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("Error opening config file %s: %s", path, err)
+	}
+	defer file.Close()
+
+	sections := make(map[string]map[string]string)
+	section := ""
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";"):
+			continue
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			if sections[section] == nil {
+				sections[section] = make(map[string]string)
+			}
+		default:
+			kv := strings.SplitN(line, "=", 2)
+			if len(kv) != 2 || section == "" {
+				continue
+			}
+			sections[section][strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("Error reading config file %s: %s", path, err)
+	}
+
+	if profile == "" {
+		profile = DefaultConfigProfile
+		if name, ok := sections[DefaultConfigProfile]["default-profile"]; ok && name != "" {
+			profile = name
+		}
+	}
+
+	values, ok := sections[profile]
+	if !ok {
+		return nil, fmt.Errorf("Error finding profile %q in config file %s", profile, path)
+	}
+
+	return &ConfigProfile{
+		Token:      values["token"],
+		APIURL:     values["api_url"],
+		APIVersion: values["api_version"],
+	}, nil
+}
@@ -0,0 +1,119 @@
+package linode
+
+import (
+	"bytes"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadConfigProfile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "linode-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "linode")
+	contents := `
+[default]
+default-profile = work
+token = default-token
+
+; a comment
+[work]
+token = work-token
+api_url = https://api.example.com
+api_version = v4beta
+`
+	if err := ioutil.WriteFile(path, []byte(contents), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	for profile, expected := range map[string]ConfigProfile{
+		"":        {Token: "work-token", APIURL: "https://api.example.com", APIVersion: "v4beta"},
+		"work":    {Token: "work-token", APIURL: "https://api.example.com", APIVersion: "v4beta"},
+		"default": {Token: "default-token"},
+	} {
+		result, err := loadConfigProfile(path, profile)
+		if err != nil {
+			t.Fatalf("unexpected error loading profile %q: %s", profile, err)
+		}
+		if *result != expected {
+			t.Errorf("expected profile %q to be %+v, got %+v", profile, expected, *result)
+		}
+	}
+
+	if _, err := loadConfigProfile(path, "missing"); err == nil {
+		t.Error("expected an error for a missing profile")
+	}
+	if _, err := loadConfigProfile(filepath.Join(dir, "missing"), ""); err == nil {
+		t.Error("expected an error for a missing config file")
+	}
+}
+
+func TestDebugTransportRedactsAuthorization(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			t.Errorf("expected the Authorization header to reach the API, got %q", r.Header.Get("Authorization"))
+		}
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer secret")
+
+	resp, err := (&debugTransport{transport: http.DefaultTransport}).RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if strings.Contains(buf.String(), "secret") {
+		t.Errorf("expected the Authorization header to be redacted, got %s", buf.String())
+	}
+	if !strings.Contains(buf.String(), "[REDACTED]") || !strings.Contains(buf.String(), "Linode API Response") {
+		t.Errorf("expected the request and response to be logged, got %s", buf.String())
+	}
+}
+
+func TestDebugTransportSendsBody(t *testing.T) {
+	var received string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		received = string(b)
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	client := &http.Client{Transport: &debugTransport{transport: http.DefaultTransport}}
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"label":"a"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if received != `{"label":"a"}` {
+		t.Errorf("expected the request body to reach the API, got %q", received)
+	}
+	if !strings.Contains(buf.String(), `{"label":"a"}`) {
+		t.Errorf("expected the request body to be logged, got %s", buf.String())
+	}
+}
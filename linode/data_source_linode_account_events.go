@@ -0,0 +1,121 @@
+package linode
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/linode/linodego"
+
+	"context"
+	"fmt"
+	"time"
+)
+
+func dataSourceLinodeAccountEvent() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeInt,
+				Description: "The unique ID of this Event.",
+				Computed:    true,
+			},
+			"action": {
+				Type:        schema.TypeString,
+				Description: "The action that caused this Event.",
+				Computed:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The current status of this Event.",
+				Computed:    true,
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Description: "The username of the User who caused this Event.",
+				Computed:    true,
+			},
+			"entity_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the entity this Event is about.",
+				Computed:    true,
+			},
+			"entity_type": {
+				Type:        schema.TypeString,
+				Description: "The type of the entity this Event is about.",
+				Computed:    true,
+			},
+			"entity_label": {
+				Type:        schema.TypeString,
+				Description: "The label of the entity this Event is about.",
+				Computed:    true,
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Description: "When this Event was created.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceLinodeAccountEvents() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLinodeAccountEventsRead,
+		Schema: map[string]*schema.Schema{
+			"filter": filterSchema([]string{"action", "entity_id", "entity_type", "status", "username"}),
+			"events": {
+				Type:        schema.TypeList,
+				Description: "The returned list of recent Events.",
+				Computed:    true,
+				Elem:        dataSourceLinodeAccountEvent(),
+			},
+		},
+	}
+}
+
+func dataSourceLinodeAccountEventsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderMeta).Client
+
+	// The entity fields are not filterable through the API, so every page of Events is listed and
+	// every filter is evaluated against the listed Events.
+	events, err := client.ListEvents(context.Background(), newListOptions(meta, ""))
+	if err != nil {
+		return fmt.Errorf("failed to list linode events: %s", err)
+	}
+
+	eventsFlattened := make([]map[string]interface{}, len(events))
+	for i, event := range events {
+		eventsFlattened[i] = flattenLinodeAccountEvent(&event)
+	}
+
+	eventsFlattened, err = filterResultsLocally(d, eventsFlattened)
+	if err != nil {
+		return fmt.Errorf("failed to filter linode events: %s", err)
+	}
+
+	d.SetId("account_events")
+	d.Set("events", eventsFlattened)
+
+	return nil
+}
+
+func flattenLinodeAccountEvent(event *linodego.Event) map[string]interface{} {
+	result := make(map[string]interface{})
+
+	result["id"] = event.ID
+	result["action"] = event.Action
+	result["status"] = event.Status
+	result["username"] = event.Username
+
+	if event.Entity != nil {
+		if event.Entity.ID != nil {
+			result["entity_id"] = fmt.Sprint(event.Entity.ID)
+		}
+		result["entity_type"] = event.Entity.Type
+		result["entity_label"] = event.Entity.Label
+	}
+
+	if event.Created != nil {
+		result["created"] = event.Created.Format(time.RFC3339)
+	}
+
+	return result
+}
@@ -0,0 +1,68 @@
+package linode
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceLinodeAccountEvents_basic(t *testing.T) {
+	t.Parallel()
+
+	label := acctest.RandomWithPrefix("tf-test")
+	resourceName := "data.linode_account_events.foobar"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceLinodeAccountEventsDomain(label),
+			},
+			{
+				Config: testDataSourceLinodeAccountEventsBasic(label),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "events.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "events.0.id"),
+					resource.TestCheckResourceAttr(resourceName, "events.0.action", "domain_create"),
+					resource.TestCheckResourceAttrSet(resourceName, "events.0.status"),
+					resource.TestCheckResourceAttrSet(resourceName, "events.0.username"),
+					resource.TestCheckResourceAttrPair(resourceName, "events.0.entity_id", "linode_domain.foobar", "id"),
+					resource.TestCheckResourceAttr(resourceName, "events.0.entity_type", "domain"),
+					resource.TestCheckResourceAttrSet(resourceName, "events.0.created"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceLinodeAccountEventsDomain(label string) string {
+	return fmt.Sprintf(`
+resource "linode_domain" "foobar" {
+	domain = "%s.example"
+	type = "master"
+	soa_email = "example@%s.example"
+}`, label, label)
+}
+
+func testDataSourceLinodeAccountEventsBasic(label string) string {
+	return testDataSourceLinodeAccountEventsDomain(label) + `
+data "linode_account_events" "foobar" {
+	filter {
+		name = "entity_type"
+		values = ["domain"]
+	}
+
+	filter {
+		name = "action"
+		values = ["domain_create"]
+	}
+
+	filter {
+		name = "entity_id"
+		values = [linode_domain.foobar.id]
+	}
+}`
+}
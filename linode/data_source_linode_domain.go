@@ -135,7 +135,7 @@ func dataSourceLinodeDomainRead(d *schema.ResourceData, meta interface{}) error
 		}
 	} else if reqDomain != "" {
 		filter, _ := json.Marshal(map[string]interface{}{"domain": reqDomain})
-		domains, err := client.ListDomains(context.Background(), linodego.NewListOptions(0, string(filter)))
+		domains, err := client.ListDomains(context.Background(), newListOptions(meta, string(filter)))
 		if err != nil {
 			return fmt.Errorf("Error listing Domains: %s", err)
 		}
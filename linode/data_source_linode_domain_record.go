@@ -102,7 +102,7 @@ func dataSourceLinodeDomainRecordRead(d *schema.ResourceData, meta interface{})
 		record = rec
 	} else if recordName != "" {
 		filter, _ := json.Marshal(map[string]interface{}{"name": recordName})
-		records, err := client.ListDomainRecords(context.Background(), domainID, linodego.NewListOptions(0, string(filter)))
+		records, err := client.ListDomainRecords(context.Background(), domainID, newListOptions(meta, string(filter)))
 		if err != nil {
 			return fmt.Errorf("Error listing domain records: %v", err)
 		}
@@ -0,0 +1,452 @@
+package linode
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceLinodeDomainZoneImportRecord() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the Record relative to the domain. Empty for the domain itself.",
+				Computed:    true,
+			},
+			"record_type": {
+				Type:        schema.TypeString,
+				Description: "The type of the Record.",
+				Computed:    true,
+			},
+			"target": {
+				Type:        schema.TypeString,
+				Description: "The target of the Record.",
+				Computed:    true,
+			},
+			"ttl_sec": {
+				Type:        schema.TypeInt,
+				Description: "The TTL of the Record in seconds, or 0 if the zone file does not set one.",
+				Computed:    true,
+			},
+			"priority": {
+				Type:        schema.TypeInt,
+				Description: "The priority of MX and SRV Records.",
+				Computed:    true,
+			},
+			"weight": {
+				Type:        schema.TypeInt,
+				Description: "The weight of SRV Records.",
+				Computed:    true,
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Description: "The port of SRV Records.",
+				Computed:    true,
+			},
+			"service": {
+				Type:        schema.TypeString,
+				Description: "The service of SRV Records, without the leading underscore.",
+				Computed:    true,
+			},
+			"protocol": {
+				Type:        schema.TypeString,
+				Description: "The protocol of SRV Records, without the leading underscore.",
+				Computed:    true,
+			},
+			"tag": {
+				Type:        schema.TypeString,
+				Description: "The tag of CAA Records.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceLinodeDomainZoneImport() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLinodeDomainZoneImportRead,
+
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:        schema.TypeString,
+				Description: "The domain the zone file describes. This is used as the initial $ORIGIN.",
+				Required:    true,
+			},
+			"zone_file": {
+				Type:        schema.TypeString,
+				Description: "The contents of a BIND-format zone file.",
+				Required:    true,
+			},
+			"records": {
+				Type:        schema.TypeList,
+				Description: "The Records parsed from the zone file.",
+				Computed:    true,
+				Elem:        dataSourceLinodeDomainZoneImportRecord(),
+			},
+		},
+	}
+}
+
+func dataSourceLinodeDomainZoneImportRead(d *schema.ResourceData, meta interface{}) error {
+	domain := strings.TrimSuffix(d.Get("domain").(string), ".")
+
+	records, err := parseDomainZoneFile(domain, d.Get("zone_file").(string))
+	if err != nil {
+		return fmt.Errorf("failed to parse zone file for %s: %s", domain, err)
+	}
+
+	d.SetId(domain)
+	d.Set("records", records)
+
+	return nil
+}
+
+// parseDomainZoneFile parses the records of a BIND-format zone file into flattened
+// linode_domain_record arguments. SOA records are skipped, as they are managed by
+// linode_domain. Only the IN class is supported.
+func parseDomainZoneFile(domain, zoneFile string) ([]map[string]interface{}, error) {
+	origin := domain + "."
+	defaultTTL := 0
+	lastName := origin
+
+	var records []map[string]interface{}
+
+	lines, err := joinDomainZoneFileLines(zoneFile)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, line := range lines {
+		tokens, err := tokenizeDomainZoneFileLine(line.text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", line.number, err)
+		}
+		if len(tokens) == 0 {
+			continue
+		}
+
+		switch strings.ToUpper(tokens[0]) {
+		case "$ORIGIN":
+			if len(tokens) != 2 {
+				return nil, fmt.Errorf("line %d: $ORIGIN expects a single domain", line.number)
+			}
+			origin = qualifyDomainZoneFileName(tokens[1], origin)
+			continue
+		case "$TTL":
+			if len(tokens) != 2 {
+				return nil, fmt.Errorf("line %d: $TTL expects a single value", line.number)
+			}
+			if defaultTTL, err = parseDomainZoneFileTTL(tokens[1]); err != nil {
+				return nil, fmt.Errorf("line %d: %s", line.number, err)
+			}
+			continue
+		case "$INCLUDE", "$GENERATE":
+			return nil, fmt.Errorf("line %d: %s is not supported", line.number, tokens[0])
+		}
+
+		// Lines starting with whitespace belong to the previous owner name
+		name := lastName
+		if !line.continued {
+			name = qualifyDomainZoneFileName(tokens[0], origin)
+			tokens = tokens[1:]
+		}
+		lastName = name
+
+		ttl := defaultTTL
+		for len(tokens) > 0 {
+			if strings.EqualFold(tokens[0], "IN") {
+				tokens = tokens[1:]
+			} else if value, err := parseDomainZoneFileTTL(tokens[0]); err == nil {
+				ttl = value
+				tokens = tokens[1:]
+			} else {
+				break
+			}
+		}
+
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("line %d: missing record type", line.number)
+		}
+
+		recordType := strings.ToUpper(tokens[0])
+		if recordType == "SOA" {
+			continue
+		}
+
+		record, err := parseDomainZoneFileRecord(recordType, tokens[1:], origin)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", line.number, err)
+		}
+
+		relativeName, err := relativeDomainZoneFileName(name, domain)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", line.number, err)
+		}
+
+		if recordType == "SRV" {
+			// Linode generates the name of SRV records from the service and protocol
+			labels := strings.SplitN(relativeName, ".", 3)
+			if len(labels) < 2 || !strings.HasPrefix(labels[0], "_") || !strings.HasPrefix(labels[1], "_") {
+				return nil, fmt.Errorf("line %d: SRV record name %q must be _service._protocol", line.number, name)
+			}
+			record["service"] = strings.TrimPrefix(labels[0], "_")
+			record["protocol"] = strings.TrimPrefix(labels[1], "_")
+			relativeName = strings.Join(labels[2:], ".")
+		}
+
+		record["name"] = relativeName
+		record["record_type"] = recordType
+		record["ttl_sec"] = ttl
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// parseDomainZoneFileRecord parses the record data of a single record.
+func parseDomainZoneFileRecord(recordType string, data []string, origin string) (map[string]interface{}, error) {
+	record := map[string]interface{}{}
+
+	expect := func(n int) error {
+		if len(data) != n {
+			return fmt.Errorf("%s record expects %d values, got %d", recordType, n, len(data))
+		}
+		return nil
+	}
+
+	switch recordType {
+	case "A", "AAAA":
+		if err := expect(1); err != nil {
+			return nil, err
+		}
+		record["target"] = data[0]
+	case "CNAME", "NS", "PTR":
+		if err := expect(1); err != nil {
+			return nil, err
+		}
+		record["target"] = strings.TrimSuffix(qualifyDomainZoneFileName(data[0], origin), ".")
+	case "MX":
+		if err := expect(2); err != nil {
+			return nil, err
+		}
+		priority, err := strconv.Atoi(data[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid MX priority %q", data[0])
+		}
+		record["priority"] = priority
+		record["target"] = strings.TrimSuffix(qualifyDomainZoneFileName(data[1], origin), ".")
+	case "TXT":
+		if len(data) < 1 {
+			return nil, fmt.Errorf("TXT record expects at least 1 value")
+		}
+		record["target"] = strings.Join(data, "")
+	case "SRV":
+		if err := expect(4); err != nil {
+			return nil, err
+		}
+		for i, key := range []string{"priority", "weight", "port"} {
+			value, err := strconv.Atoi(data[i])
+			if err != nil {
+				return nil, fmt.Errorf("invalid SRV %s %q", key, data[i])
+			}
+			record[key] = value
+		}
+		record["target"] = strings.TrimSuffix(qualifyDomainZoneFileName(data[3], origin), ".")
+	case "CAA":
+		if err := expect(3); err != nil {
+			return nil, err
+		}
+		record["tag"] = data[1]
+		record["target"] = data[2]
+	default:
+		return nil, fmt.Errorf("unsupported record type %s", recordType)
+	}
+
+	return record, nil
+}
+
+type domainZoneFileLine struct {
+	number    int
+	text      string
+	continued bool
+}
+
+// joinDomainZoneFileLines strips comments and joins parenthesized records spanning
+// multiple lines into a single line.
+func joinDomainZoneFileLines(zoneFile string) ([]domainZoneFileLine, error) {
+	var lines []domainZoneFileLine
+	var current *domainZoneFileLine
+	depth := 0
+
+	for i, text := range strings.Split(zoneFile, "\n") {
+		text = stripDomainZoneFileComment(strings.TrimRight(text, "\r"))
+
+		if current == nil {
+			if strings.TrimSpace(text) == "" {
+				continue
+			}
+			current = &domainZoneFileLine{
+				number:    i + 1,
+				continued: text[0] == ' ' || text[0] == '\t',
+			}
+		}
+
+		text, delta := replaceDomainZoneFileParentheses(text)
+		depth += delta
+		current.text += " " + text
+
+		if depth < 0 {
+			return nil, fmt.Errorf("line %d: unbalanced parentheses", i+1)
+		}
+		if depth == 0 {
+			lines = append(lines, *current)
+			current = nil
+		}
+	}
+
+	if current != nil {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", current.number)
+	}
+
+	return lines, nil
+}
+
+// replaceDomainZoneFileParentheses replaces the parentheses that are not inside a quoted
+// string with spaces, returning the change in nesting depth.
+func replaceDomainZoneFileParentheses(text string) (string, int) {
+	result := []byte(text)
+	quoted, delta := false, 0
+	for i := 0; i < len(result); i++ {
+		switch result[i] {
+		case '\\':
+			i++
+		case '"':
+			quoted = !quoted
+		case '(', ')':
+			if quoted {
+				continue
+			}
+			if result[i] == '(' {
+				delta++
+			} else {
+				delta--
+			}
+			result[i] = ' '
+		}
+	}
+	return string(result), delta
+}
+
+// stripDomainZoneFileComment removes a trailing ; comment that is not inside a quoted string.
+func stripDomainZoneFileComment(text string) string {
+	quoted := false
+	for i := 0; i < len(text); i++ {
+		switch text[i] {
+		case '\\':
+			i++
+		case '"':
+			quoted = !quoted
+		case ';':
+			if !quoted {
+				return text[:i]
+			}
+		}
+	}
+	return text
+}
+
+// tokenizeDomainZoneFileLine splits a line on whitespace, keeping quoted strings together
+// and removing their quotes.
+func tokenizeDomainZoneFileLine(text string) ([]string, error) {
+	var tokens []string
+	var token strings.Builder
+	inToken, quoted := false, false
+
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case c == '\\' && i+1 < len(text):
+			i++
+			token.WriteByte(text[i])
+			inToken = true
+		case c == '"':
+			quoted = !quoted
+			inToken = true
+		case !quoted && (c == ' ' || c == '\t'):
+			if inToken {
+				tokens = append(tokens, token.String())
+				token.Reset()
+				inToken = false
+			}
+		default:
+			token.WriteByte(c)
+			inToken = true
+		}
+	}
+
+	if quoted {
+		return nil, fmt.Errorf("unterminated quoted string")
+	}
+	if inToken {
+		tokens = append(tokens, token.String())
+	}
+	return tokens, nil
+}
+
+// parseDomainZoneFileTTL parses a TTL given in seconds or with BIND unit suffixes (e.g. 1h30m).
+func parseDomainZoneFileTTL(value string) (int, error) {
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return seconds, nil
+	}
+
+	units := map[byte]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	total, number := 0, ""
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if c >= '0' && c <= '9' {
+			number += string(c)
+			continue
+		}
+
+		unit, ok := units[c|0x20]
+		if !ok || number == "" {
+			return 0, fmt.Errorf("invalid TTL %q", value)
+		}
+		n, _ := strconv.Atoi(number)
+		total += n * unit
+		number = ""
+	}
+
+	if number != "" {
+		return 0, fmt.Errorf("invalid TTL %q", value)
+	}
+	return total, nil
+}
+
+// qualifyDomainZoneFileName returns the fully qualified name, with a trailing dot.
+func qualifyDomainZoneFileName(name, origin string) string {
+	if name == "@" {
+		return origin
+	}
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "." + origin
+}
+
+// relativeDomainZoneFileName returns the fully qualified name relative to the domain.
+func relativeDomainZoneFileName(name, domain string) (string, error) {
+	name = strings.TrimSuffix(name, ".")
+	if strings.EqualFold(name, domain) {
+		return "", nil
+	}
+
+	suffix := "." + domain
+	if len(name) <= len(suffix) || !strings.EqualFold(name[len(name)-len(suffix):], suffix) {
+		return "", fmt.Errorf("record name %q is outside of the domain %s", name, domain)
+	}
+	return name[:len(name)-len(suffix)], nil
+}
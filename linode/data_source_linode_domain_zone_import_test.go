@@ -0,0 +1,77 @@
+package linode
+
+import (
+	"reflect"
+	"testing"
+)
+
+const testDomainZoneFile = `
+$ORIGIN example.com.
+$TTL 1h
+@       IN SOA ns1.linode.com. admin.example.com. (
+            2021010101 ; serial
+            14400      ; refresh
+            14400      ; retry
+            1209600    ; expire
+            86400 )    ; minimum
+@          IN  A      192.0.2.1
+           IN  AAAA   2001:db8::1
+www    300 IN  CNAME  @
+mail       IN  MX     10 mx1.example.net.
+@          IN  TXT    "v=spf1 include:example.net ~all" ; trailing comment
+long       IN  TXT    ( "part one; "
+                        "part two" )
+_sip._tcp  IN  SRV    10 60 5060 sip
+@          IN  CAA    0 issue "letsencrypt.org"
+
+$ORIGIN sub.example.com.
+api    86400 A 192.0.2.2
+`
+
+func TestParseDomainZoneFile(t *testing.T) {
+	records, err := parseDomainZoneFile("example.com", testDomainZoneFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []map[string]interface{}{
+		{"name": "", "record_type": "A", "target": "192.0.2.1", "ttl_sec": 3600},
+		{"name": "", "record_type": "AAAA", "target": "2001:db8::1", "ttl_sec": 3600},
+		{"name": "www", "record_type": "CNAME", "target": "example.com", "ttl_sec": 300},
+		{"name": "mail", "record_type": "MX", "target": "mx1.example.net", "priority": 10, "ttl_sec": 3600},
+		{"name": "", "record_type": "TXT", "target": "v=spf1 include:example.net ~all", "ttl_sec": 3600},
+		{"name": "long", "record_type": "TXT", "target": "part one; part two", "ttl_sec": 3600},
+		{
+			"name": "", "record_type": "SRV", "target": "sip.example.com", "service": "sip", "protocol": "tcp",
+			"priority": 10, "weight": 60, "port": 5060, "ttl_sec": 3600,
+		},
+		{"name": "", "record_type": "CAA", "tag": "issue", "target": "letsencrypt.org", "ttl_sec": 3600},
+		{"name": "api.sub", "record_type": "A", "target": "192.0.2.2", "ttl_sec": 86400},
+	}
+
+	if len(records) != len(expected) {
+		t.Fatalf("expected %d records, got %d: %v", len(expected), len(records), records)
+	}
+
+	for i := range expected {
+		if !reflect.DeepEqual(records[i], expected[i]) {
+			t.Errorf("record %d: expected %v, got %v", i, expected[i], records[i])
+		}
+	}
+}
+
+func TestParseDomainZoneFile_invalid(t *testing.T) {
+	for _, zoneFile := range []string{
+		"@ IN A",
+		"@ IN A 192.0.2.1 192.0.2.2",
+		"@ IN HINFO x86 Linux",
+		"other.org. IN A 192.0.2.1",
+		"@ IN TXT \"unterminated",
+		"@ IN SOA ns1.linode.com. admin.example.com. ( 1 2 3 4 5",
+		"$INCLUDE other.zone",
+	} {
+		if _, err := parseDomainZoneFile("example.com", zoneFile); err == nil {
+			t.Errorf("expected an error parsing %q", zoneFile)
+		}
+	}
+}
@@ -113,7 +113,7 @@ func dataSourceLinodeFirewall() *schema.Resource {
 			},
 			"devices": {
 				Type:        schema.TypeList,
-				Elem:        resourceLinodeFirewallDevice(),
+				Elem:        resourceLinodeFirewallGovernedDevice(),
 				Description: "The devices associated with this firewall.",
 				Computed:    true,
 			},
@@ -140,7 +140,7 @@ func datasourceLinodeFirewallRead(ctx context.Context, d *schema.ResourceData, m
 		diag.Errorf("failed to get firewall rules %d: %s", id, err)
 	}
 
-	devices, err := client.ListFirewallDevices(context.Background(), id, nil)
+	devices, err := client.ListFirewallDevices(context.Background(), id, newListOptions(meta, ""))
 	if err != nil {
 		diag.Errorf("failed to get firewall devices %d: %s", id, err)
 	}
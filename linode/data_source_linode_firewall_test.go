@@ -46,7 +46,7 @@ func TestAccDataSourceLinodeFirewall_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(testFirewallDataName, "devices.0.type", "linode"),
 					resource.TestCheckResourceAttr(testFirewallDataName, "linodes.#", "1"),
 					resource.TestCheckResourceAttr(testFirewallDataName, "tags.#", "1"),
-					resource.TestCheckResourceAttr(testFirewallDataName, "tags.0", "test"),
+					resource.TestCheckTypeSetElemAttr(testFirewallDataName, "tags.*", "test"),
 					resource.TestCheckResourceAttrSet(testFirewallDataName, "devices.0.url"),
 					resource.TestCheckResourceAttrSet(testFirewallDataName, "devices.0.id"),
 					resource.TestCheckResourceAttrSet(testFirewallDataName, "devices.0.entity_id"),
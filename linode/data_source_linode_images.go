@@ -2,10 +2,12 @@ package linode
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/linode/linodego"
 
 	"context"
 	"fmt"
+	"regexp"
 	"strconv"
 )
 
@@ -14,6 +16,18 @@ func dataSourceLinodeImages() *schema.Resource {
 		Read: dataSourceLinodeImagesRead,
 		Schema: map[string]*schema.Schema{
 			"filter": filterSchema([]string{"deprecated", "is_public", "label", "size", "vendor"}),
+			"label_regex": {
+				Type:         schema.TypeString,
+				Description:  "A regular expression that the label of returned Images must match.",
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"most_recent": {
+				Type:        schema.TypeBool,
+				Description: "If true, only the most recently created Image matching the query is returned.",
+				Optional:    true,
+				Default:     false,
+			},
 			"images": {
 				Type:        schema.TypeList,
 				Description: "The returned list of Images.",
@@ -32,19 +46,35 @@ func dataSourceLinodeImagesRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("failed to construct filter: %s", err)
 	}
 
-	images, err := client.ListImages(context.Background(), &linodego.ListOptions{
-		Filter: filter,
-	})
+	images, err := client.ListImages(context.Background(), newListOptions(meta, filter))
 
 	if err != nil {
 		return fmt.Errorf("failed to list linode images: %s", err)
 	}
 
-	imagesFlattened := make([]interface{}, len(images))
+	if labelRegex, ok := d.GetOk("label_regex"); ok {
+		images = filterLinodeImagesByLabel(images, regexp.MustCompile(labelRegex.(string)))
+	}
+
+	imagesFlattened := make([]map[string]interface{}, len(images))
 	for i, image := range images {
 		imagesFlattened[i] = flattenLinodeImage(&image)
 	}
 
+	imagesFlattened, err = filterResults(d, imagesFlattened)
+	if err != nil {
+		return fmt.Errorf("failed to filter linode images: %s", err)
+	}
+
+	if d.Get("most_recent").(bool) {
+		images = mostRecentLinodeImage(filterLinodeImagesByFlattened(images, imagesFlattened))
+
+		imagesFlattened = make([]map[string]interface{}, len(images))
+		for i, image := range images {
+			imagesFlattened[i] = flattenLinodeImage(&image)
+		}
+	}
+
 	d.SetId(filter)
 	d.Set("images", imagesFlattened)
 
@@ -62,3 +92,49 @@ func imageValueToFilterType(filterName, value string) (interface{}, error) {
 
 	return value, nil
 }
+
+func filterLinodeImagesByLabel(images []linodego.Image, labelRegex *regexp.Regexp) []linodego.Image {
+	result := make([]linodego.Image, 0, len(images))
+	for _, image := range images {
+		if labelRegex.MatchString(image.Label) {
+			result = append(result, image)
+		}
+	}
+	return result
+}
+
+// filterLinodeImagesByFlattened returns the images that remain in the given
+// flattened images after client-side filtering.
+func filterLinodeImagesByFlattened(images []linodego.Image, flattened []map[string]interface{}) []linodego.Image {
+	ids := make(map[string]bool, len(flattened))
+	for _, image := range flattened {
+		ids[image["id"].(string)] = true
+	}
+
+	result := make([]linodego.Image, 0, len(flattened))
+	for _, image := range images {
+		if ids[image.ID] {
+			result = append(result, image)
+		}
+	}
+	return result
+}
+
+// mostRecentLinodeImage returns a slice containing only the most recently
+// created image, or an empty slice if there are no images.
+func mostRecentLinodeImage(images []linodego.Image) []linodego.Image {
+	var latest *linodego.Image
+	for i, image := range images {
+		if image.Created == nil {
+			continue
+		}
+		if latest == nil || image.Created.After(*latest.Created) {
+			latest = &images[i]
+		}
+	}
+
+	if latest == nil {
+		return []linodego.Image{}
+	}
+	return []linodego.Image{*latest}
+}
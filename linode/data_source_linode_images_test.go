@@ -33,6 +33,28 @@ func TestAccDataSourceLinodeImages_basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceLinodeImages_mostRecent(t *testing.T) {
+	t.Parallel()
+
+	imageName := acctest.RandomWithPrefix("tf_test")
+	resourceName := "data.linode_images.foobar"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceLinodeImagesMostRecent(imageName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "images.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "images.0.label", imageName),
+					resource.TestCheckResourceAttrPair(resourceName, "images.0.id", "linode_image.foobar", "id"),
+				),
+			},
+		},
+	})
+}
+
 func testDataSourceLinodeImagesBasic(image string) string {
 	return testAccCheckLinodeImageConfigBasic(image) + `
 data "linode_images" "foobar" {
@@ -47,3 +69,16 @@ data "linode_images" "foobar" {
 	}
 }`
 }
+
+func testDataSourceLinodeImagesMostRecent(image string) string {
+	return testAccCheckLinodeImageConfigBasic(image) + `
+data "linode_images" "foobar" {
+	label_regex = "^${linode_image.foobar.label}$"
+	most_recent = true
+
+	filter {
+		name = "is_public"
+		values = ["false"]
+	}
+}`
+}
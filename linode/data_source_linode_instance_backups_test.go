@@ -65,6 +65,11 @@ func TestAccDataSourceLinodeInstanceBackups_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "current.0.created"),
 					resource.TestCheckResourceAttrSet(resourceName, "current.0.updated"),
 					resource.TestCheckResourceAttrSet(resourceName, "current.0.finished"),
+					resource.TestCheckResourceAttr(resourceName, "current.0.configs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "current.0.disks.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "current.0.disks.0.label"),
+					resource.TestCheckResourceAttrSet(resourceName, "current.0.disks.0.size"),
+					resource.TestCheckResourceAttrSet(resourceName, "current.0.disks.0.filesystem"),
 				),
 			},
 		},
@@ -117,7 +117,7 @@ func dataSourceLinodeInstanceType() *schema.Resource {
 func dataSourceLinodeInstanceTypeRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ProviderMeta).Client
 
-	types, err := client.ListTypes(context.Background(), nil)
+	types, err := client.ListTypes(context.Background(), newListOptions(meta, ""))
 	if err != nil {
 		return fmt.Errorf("Error listing ranges: %s", err)
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/linode/linodego"
@@ -12,6 +13,11 @@ import (
 func dataSourceLinodeInstancesInstances() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the Linode Instance.",
+				Computed:    true,
+			},
 			"image": {
 				Type: schema.TypeString,
 				Description: "An Image ID to deploy the Disk from. Official Linode Images start with linode/, while " +
@@ -56,6 +62,27 @@ func dataSourceLinodeInstancesInstances() *schema.Resource {
 				Description: "The status of the instance, indicating the current readiness state.",
 				Computed:    true,
 			},
+			"created": {
+				Type:        schema.TypeString,
+				Description: "When this Linode was created.",
+				Computed:    true,
+			},
+			"updated": {
+				Type:        schema.TypeString,
+				Description: "When this Linode was last updated.",
+				Computed:    true,
+			},
+			"hypervisor": {
+				Type:        schema.TypeString,
+				Description: "The virtualization software powering this Linode.",
+				Computed:    true,
+			},
+			"maintenance": {
+				Type:        schema.TypeList,
+				Description: "The pending host maintenance and migrations of this Linode.",
+				Computed:    true,
+				Elem:        resourceLinodeInstanceMaintenance(),
+			},
 			"ip_address": {
 				Type: schema.TypeString,
 				Description: "This Linode's Public IPv4 Address. If there are multiple public IPv4 addresses on this " +
@@ -82,6 +109,12 @@ func dataSourceLinodeInstancesInstances() *schema.Resource {
 					"192.168.128/17 address shared by all Linode Instances in a region.",
 				Computed: true,
 			},
+			"disk_filesystem_paths": {
+				Type:        schema.TypeMap,
+				Description: "A map of Disk labels to the device paths (e.g. /dev/sda) they are attached at.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+			},
 			"swap_size": {
 				Type: schema.TypeInt,
 				Description: "When deploying from an Image, this field is optional with a Linode API default of " +
@@ -130,20 +163,37 @@ func dataSourceLinodeInstancesInstances() *schema.Resource {
 				},
 			},
 
+			"price": {
+				Type:        schema.TypeList,
+				Description: "The cost of this Linode's type in US dollars, broken down into hourly and monthly charges.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hourly": {
+							Type:        schema.TypeFloat,
+							Description: "Cost (in US dollars) per hour.",
+							Computed:    true,
+						},
+						"monthly": {
+							Type:        schema.TypeFloat,
+							Description: "Cost (in US dollars) per month.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+
 			"alerts": {
 				Computed: true,
 				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"cpu": {
 							Type:     schema.TypeInt,
 							Computed: true,
 							Description: "The percentage of CPU usage required to trigger an alert. If the average " +
-								"CPU usage over two hours exceeds this value, we'll Device can be either a Disk or Volume " +
-								"identified by disk_id or volume_id. Only one type per slot allowed.send you an alert. If " +
-								"this is set to 0, the alert is disabled.",
+								"CPU usage over two hours exceeds this value, we'll send you an alert. If this is set to 0, " +
+								"the alert is disabled.",
 						},
 						"network_in": {
 							Type:     schema.TypeInt,
@@ -256,7 +306,7 @@ func dataSourceLinodeInstancesInstances() *schema.Resource {
 									},
 									"devtmpfs_automount": {
 										Type:        schema.TypeBool,
-										Description: "Populates the /dev directory early during boot without udev. Defaults to false.",
+										Description: "Populates the /dev directory early during boot without udev.",
 										Computed:    true,
 									},
 								},
@@ -387,6 +437,11 @@ func dataSourceLinodeInstancesInstances() *schema.Resource {
 							Description: "The Disk filesystem can be one of: raw, swap, ext3, ext4, initrd (max 32mb)",
 							Computed:    true,
 						},
+						"filesystem_path": {
+							Type:        schema.TypeString,
+							Description: "The device path (e.g. /dev/sda) the Disk is attached at in the boot Config.",
+							Computed:    true,
+						},
 					},
 				},
 			},
@@ -398,7 +453,7 @@ func dataSourceLinodeInstances() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceLinodeInstancesRead,
 		Schema: map[string]*schema.Schema{
-			"filter": filterSchema([]string{"group", "id", "image", "label", "region", "tags"}),
+			"filter": filterSchema([]string{"group", "id", "image", "label", "region", filterTagsName}),
 			"instances": {
 				Type:        schema.TypeList,
 				Description: "The returned list of Instances.",
@@ -417,30 +472,45 @@ func dataSourceLinodeInstancesRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("failed to construct filter: %s", err)
 	}
 
-	instances, err := client.ListInstances(context.Background(), &linodego.ListOptions{
-		Filter: filter,
-	})
+	instances, err := client.ListInstances(context.Background(), newListOptions(meta, filter))
 	if err != nil {
 		return fmt.Errorf("failed to get instances: %s", err)
 	}
 
+	types := make(map[string]*linodego.LinodeType)
+
+	maintenance, err := listInstanceMaintenance(context.Background(), client)
+	if err != nil {
+		return err
+	}
+
 	flattenedInstances := make([]map[string]interface{}, len(instances))
 	for i, instance := range instances {
-		instanceMap, err := flattenLinodeInstance(&client, &instance)
+		instanceMap, err := flattenLinodeInstance(&client, &instance, types)
 		if err != nil {
 			return fmt.Errorf("failed to translate instance to map: %s", err)
 		}
 
+		instanceMap["maintenance"] = maintenance[instance.ID]
 		flattenedInstances[i] = instanceMap
 	}
 
+	flattenedInstances, err = filterResults(d, flattenedInstances)
+	if err != nil {
+		return fmt.Errorf("failed to filter instances: %s", err)
+	}
+
 	d.SetId(fmt.Sprintf(filter))
 	d.Set("instances", flattenedInstances)
 
 	return nil
 }
 
-func flattenLinodeInstance(client *linodego.Client, instance *linodego.Instance) (map[string]interface{}, error) {
+// flattenLinodeInstance translates an instance into its data source map. Looked up types are
+// cached in types, since most instances in a listing share a handful of types.
+func flattenLinodeInstance(
+	client *linodego.Client, instance *linodego.Instance, types map[string]*linodego.LinodeType,
+) (map[string]interface{}, error) {
 	result := make(map[string]interface{})
 
 	id := instance.ID
@@ -468,8 +538,16 @@ func flattenLinodeInstance(client *linodego.Client, instance *linodego.Instance)
 		result["private_ip_address"] = private[0].Address
 	}
 
+	result["id"] = instance.ID
 	result["label"] = instance.Label
 	result["status"] = instance.Status
+	result["hypervisor"] = instance.Hypervisor
+	if instance.Created != nil {
+		result["created"] = instance.Created.Format(time.RFC3339)
+	}
+	if instance.Updated != nil {
+		result["updated"] = instance.Updated.Format(time.RFC3339)
+	}
 	result["type"] = instance.Type
 	result["region"] = instance.Region
 	result["watchdog_enabled"] = instance.WatchdogEnabled
@@ -481,20 +559,32 @@ func flattenLinodeInstance(client *linodego.Client, instance *linodego.Instance)
 	result["specs"] = flattenInstanceSpecs(*instance)
 	result["alerts"] = flattenInstanceAlerts(*instance)
 
+	instanceType, ok := types[instance.Type]
+	if !ok {
+		instanceType, err = client.GetType(context.Background(), instance.Type)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the type %s of Linode instance %d: %s", instance.Type, id, err)
+		}
+		types[instance.Type] = instanceType
+	}
+	result["price"] = flattenInstancePrice(instanceType)
+
 	instanceDisks, err := client.ListInstanceDisks(context.Background(), int(id), nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get the disks for the Linode instance %d: %s", id, err)
 	}
 
-	disks, swapSize := flattenInstanceDisks(instanceDisks)
-	result["disk"] = disks
-	result["swap_size"] = swapSize
-
 	instanceConfigs, err := client.ListInstanceConfigs(context.Background(), int(id), nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get the config for Linode instance %d (%s): %s", id, instance.Label, err)
 	}
 
+	filesystemPaths := getInstanceDiskFilesystemPaths(instanceConfigs, "")
+	disks, swapSize := flattenInstanceDisks(instanceDisks, filesystemPaths)
+	result["disk"] = disks
+	result["disk_filesystem_paths"] = flattenInstanceDiskFilesystemPaths(instanceDisks, filesystemPaths)
+	result["swap_size"] = swapSize
+
 	diskLabelIDMap := make(map[int]string, len(instanceDisks))
 	for _, disk := range instanceDisks {
 		diskLabelIDMap[disk.ID] = disk.Label
@@ -26,6 +26,14 @@ func TestAccDataSourceLinodeInstances_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resName, "instances.0.tags.#", "2"),
 					resource.TestCheckResourceAttr(resName, "instances.0.image", "linode/ubuntu18.04"),
 					resource.TestCheckResourceAttr(resName, "instances.0.region", "us-southeast"),
+					resource.TestCheckResourceAttr(resName, "instances.0.hypervisor", "kvm"),
+					resource.TestCheckResourceAttr(resName, "instances.0.maintenance.#", "0"),
+					resource.TestCheckResourceAttrSet(resName, "instances.0.created"),
+					resource.TestCheckResourceAttrSet(resName, "instances.0.updated"),
+					resource.TestCheckResourceAttrSet(resName, "instances.0.price.0.monthly"),
+					resource.TestCheckResourceAttr(resName, "instances.0.watchdog_enabled", "true"),
+					resource.TestCheckResourceAttr(resName, "instances.0.backups.0.enabled", "false"),
+					resource.TestCheckResourceAttrSet(resName, "instances.0.alerts.0.cpu"),
 					resource.TestCheckResourceAttr(resName, "instances.0.group", "tf_test"),
 					resource.TestCheckResourceAttr(resName, "instances.0.swap_size", "256"),
 					resource.TestCheckResourceAttr(resName, "instances.0.ipv4.#", "2"),
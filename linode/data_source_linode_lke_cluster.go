@@ -96,6 +96,16 @@ func dataSourceLinodeLKECluster() *schema.Resource {
 										Computed:    true,
 										Description: `The status of the node.`,
 									},
+									"ip_address": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The public IPv4 address of the underlying Linode instance.",
+									},
+									"private_ip_address": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The private IPv4 address of the underlying Linode instance.",
+									},
 								},
 							},
 							Computed:    true,
@@ -119,7 +129,7 @@ func datasourceLinodeLKEClusterRead(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("failed to get LKE cluster %d: %s", id, err)
 	}
 
-	pools, err := client.ListLKEClusterPools(context.Background(), id, nil)
+	pools, err := client.ListLKEClusterPools(context.Background(), id, newListOptions(meta, ""))
 	if err != nil {
 		return diag.Errorf("failed to get pools for LKE cluster %d: %s", id, err)
 	}
@@ -129,11 +139,16 @@ func datasourceLinodeLKEClusterRead(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("failed to get kubeconfig for LKE cluster %d: %s", id, err)
 	}
 
-	endpoints, err := client.ListLKEClusterAPIEndpoints(context.Background(), id, nil)
+	endpoints, err := client.ListLKEClusterAPIEndpoints(context.Background(), id, newListOptions(meta, ""))
 	if err != nil {
 		return diag.Errorf("failed to get API endpoints for LKE cluster %d: %s", id, err)
 	}
 
+	nodeIPs, err := getLinodeLKEClusterNodeIPs(context.Background(), &client, pools)
+	if err != nil {
+		return diag.Errorf("failed to get node IPs for LKE cluster %d: %s", id, err)
+	}
+
 	d.SetId(strconv.Itoa(id))
 	d.Set("label", cluster.Label)
 	d.Set("k8s_version", cluster.K8sVersion)
@@ -141,7 +156,7 @@ func datasourceLinodeLKEClusterRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("tags", cluster.Tags)
 	d.Set("status", cluster.Status)
 	d.Set("kubeconfig", kubeconfig.KubeConfig)
-	d.Set("pools", flattenLinodeLKEClusterPools(pools))
+	d.Set("pools", flattenLinodeLKEClusterPools(pools, nodeIPs))
 	d.Set("api_endpoints", flattenLinodeLKEClusterAPIEndpoints(endpoints))
 	return nil
 }
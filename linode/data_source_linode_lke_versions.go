@@ -0,0 +1,42 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceLinodeLKEVersions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLinodeLKEVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:        schema.TypeList,
+				Description: "The Kubernetes versions available for LKE clusters.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceLinodeLKEVersionsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderMeta).Client
+
+	versions, err := client.ListLKEVersions(context.Background(), newListOptions(meta, ""))
+	if err != nil {
+		return fmt.Errorf("failed to list LKE versions: %s", err)
+	}
+
+	ids := make([]string, len(versions))
+	for i, version := range versions {
+		ids[i] = version.ID
+	}
+
+	d.SetId("lke_versions")
+	d.Set("ids", ids)
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package linode
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceLinodeLKEVersions_basic(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "data.linode_lke_versions.foobar"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceLinodeLKEVersionsBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "ids.#"),
+					resource.TestCheckResourceAttrSet(resourceName, "ids.0"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceLinodeLKEVersionsBasic() string {
+	return `
+data "linode_lke_versions" "foobar" {}`
+}
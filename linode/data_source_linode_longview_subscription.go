@@ -0,0 +1,82 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceLinodeLongviewSubscription() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLinodeLongviewSubscriptionRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Description: "The unique ID of this Longview subscription plan.",
+				Required:    true,
+			},
+			"label": {
+				Type:        schema.TypeString,
+				Description: "The display label of this Longview subscription plan.",
+				Computed:    true,
+			},
+			"clients_included": {
+				Type:        schema.TypeInt,
+				Description: "The number of Longview clients included with this subscription plan.",
+				Computed:    true,
+			},
+			"price": {
+				Type:        schema.TypeList,
+				Description: "Cost in US dollars, broken down into hourly and monthly charges.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hourly": {
+							Type:        schema.TypeFloat,
+							Description: "Cost (in US dollars) per hour.",
+							Computed:    true,
+						},
+						"monthly": {
+							Type:        schema.TypeFloat,
+							Description: "Cost (in US dollars) per month.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLinodeLongviewSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderMeta).Client
+
+	subscriptions, err := client.ListLongviewSubscriptions(context.Background(), newListOptions(meta, ""))
+	if err != nil {
+		return fmt.Errorf("Error listing Longview subscriptions: %s", err)
+	}
+
+	reqSubscription := d.Get("id").(string)
+
+	for _, subscription := range subscriptions {
+		if subscription.ID == reqSubscription {
+			d.SetId(subscription.ID)
+			d.Set("label", subscription.Label)
+			d.Set("clients_included", subscription.ClientsIncluded)
+
+			if subscription.Price != nil {
+				d.Set("price", []map[string]interface{}{{
+					"hourly":  subscription.Price.Hourly,
+					"monthly": subscription.Price.Monthly,
+				}})
+			}
+			return nil
+		}
+	}
+
+	d.SetId("")
+
+	return fmt.Errorf("Longview subscription %s was not found", reqSubscription)
+}
@@ -0,0 +1,39 @@
+package linode
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceLinodeLongviewSubscription_basic(t *testing.T) {
+	t.Parallel()
+
+	subscriptionID := "longview-3"
+	resourceName := "data.linode_longview_subscription.foobar"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceLinodeLongviewSubscriptionBasic(subscriptionID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", subscriptionID),
+					resource.TestCheckResourceAttr(resourceName, "label", "Longview Pro 3 pack"),
+					resource.TestCheckResourceAttr(resourceName, "clients_included", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "price.0.hourly"),
+					resource.TestCheckResourceAttrSet(resourceName, "price.0.monthly"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceLinodeLongviewSubscriptionBasic(subscriptionID string) string {
+	return fmt.Sprintf(`
+data "linode_longview_subscription" "foobar" {
+	id = "%s"
+}`, subscriptionID)
+}
@@ -0,0 +1,188 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/linode/linodego"
+)
+
+func dataSourceLinodeNetworkingIPAddress() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"address": {
+				Type:        schema.TypeString,
+				Description: "The IP address.",
+				Computed:    true,
+			},
+			"gateway": {
+				Type:        schema.TypeString,
+				Description: "The default gateway for this address.",
+				Computed:    true,
+			},
+			"subnet_mask": {
+				Type:        schema.TypeString,
+				Description: "The mask that separates host bits from network bits for this address.",
+				Computed:    true,
+			},
+			"prefix": {
+				Type:        schema.TypeInt,
+				Description: "The number of bits set in the subnet mask.",
+				Computed:    true,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Description: "The type of address this is (ipv4, ipv6, ipv6/pool, ipv6/range).",
+				Computed:    true,
+			},
+			"public": {
+				Type:        schema.TypeBool,
+				Description: "Whether this is a public or private IP address.",
+				Computed:    true,
+			},
+			"rdns": {
+				Type:        schema.TypeString,
+				Description: "The reverse DNS assigned to this address.",
+				Computed:    true,
+			},
+			"linode_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the Linode this address currently belongs to.",
+				Computed:    true,
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Description: "The Region this IP address resides in.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceLinodeNetworkingIPv6Range() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"range": {
+				Type:        schema.TypeString,
+				Description: "The IPv6 range or pool.",
+				Computed:    true,
+			},
+			"prefix": {
+				Type:        schema.TypeInt,
+				Description: "The prefix length of the address.",
+				Computed:    true,
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Description: "The Region this range or pool resides in.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceLinodeNetworkingIPs() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLinodeNetworkingIPsRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": filterSchema([]string{"region"}),
+			"ip_addresses": {
+				Type:        schema.TypeList,
+				Description: "The IP addresses on your account.",
+				Computed:    true,
+				Elem:        dataSourceLinodeNetworkingIPAddress(),
+			},
+			"ipv6_ranges": {
+				Type:        schema.TypeList,
+				Description: "The IPv6 ranges on your account.",
+				Computed:    true,
+				Elem:        dataSourceLinodeNetworkingIPv6Range(),
+			},
+			"ipv6_pools": {
+				Type:        schema.TypeList,
+				Description: "The IPv6 pools available to your account.",
+				Computed:    true,
+				Elem:        dataSourceLinodeNetworkingIPv6Range(),
+			},
+		},
+	}
+}
+
+func dataSourceLinodeNetworkingIPsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderMeta).Client
+
+	filter, err := constructFilterString(d, networkingIPsValueToFilterType)
+	if err != nil {
+		return fmt.Errorf("failed to construct filter: %s", err)
+	}
+
+	addresses, err := client.ListIPAddresses(context.Background(), newListOptions(meta, filter))
+	if err != nil {
+		return fmt.Errorf("Error listing addresses: %s", err)
+	}
+
+	ranges, err := client.ListIPv6Ranges(context.Background(), newListOptions(meta, filter))
+	if err != nil {
+		return fmt.Errorf("Error listing IPv6 ranges: %s", err)
+	}
+
+	pools, err := client.ListIPv6Pools(context.Background(), newListOptions(meta, filter))
+	if err != nil {
+		return fmt.Errorf("Error listing IPv6 pools: %s", err)
+	}
+
+	addressesFlattened := make([]map[string]interface{}, len(addresses))
+	for i, address := range addresses {
+		addressesFlattened[i] = map[string]interface{}{
+			"address":     address.Address,
+			"gateway":     address.Gateway,
+			"subnet_mask": address.SubnetMask,
+			"prefix":      address.Prefix,
+			"type":        address.Type,
+			"public":      address.Public,
+			"rdns":        address.RDNS,
+			"linode_id":   address.LinodeID,
+			"region":      address.Region,
+		}
+	}
+
+	addressesFlattened, err = filterResults(d, addressesFlattened)
+	if err != nil {
+		return fmt.Errorf("failed to filter addresses: %s", err)
+	}
+
+	rangesFlattened, err := filterResults(d, flattenLinodeNetworkingIPv6Ranges(ranges))
+	if err != nil {
+		return fmt.Errorf("failed to filter IPv6 ranges: %s", err)
+	}
+
+	poolsFlattened, err := filterResults(d, flattenLinodeNetworkingIPv6Ranges(pools))
+	if err != nil {
+		return fmt.Errorf("failed to filter IPv6 pools: %s", err)
+	}
+
+	d.SetId(filter)
+	d.Set("ip_addresses", addressesFlattened)
+	d.Set("ipv6_ranges", rangesFlattened)
+	d.Set("ipv6_pools", poolsFlattened)
+
+	return nil
+}
+
+func networkingIPsValueToFilterType(_, value string) (interface{}, error) {
+	return value, nil
+}
+
+func flattenLinodeNetworkingIPv6Ranges(ranges []linodego.IPv6Range) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(ranges))
+	for i, r := range ranges {
+		result[i] = map[string]interface{}{
+			"range":  r.Range,
+			"prefix": r.Prefix,
+			"region": r.Region,
+		}
+	}
+	return result
+}
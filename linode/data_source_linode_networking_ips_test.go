@@ -0,0 +1,51 @@
+package linode
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceLinodeNetworkingIPs_region(t *testing.T) {
+	t.Parallel()
+
+	dataResourceName := "data.linode_networking_ips.foobar"
+
+	label := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: accTestWithProvider(testDataSourceLinodeNetworkingIPsRegion(label), map[string]interface{}{
+					providerKeySkipInstanceReadyPoll: true,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataResourceName, "filter.0.values.0", "us-east"),
+					testAccCheckResourceNonEmptyList(dataResourceName, "ip_addresses"),
+					resource.TestCheckResourceAttr(dataResourceName, "ip_addresses.0.region", "us-east"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceLinodeNetworkingIPsRegion(label string) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label = "%s"
+	image = "linode/alpine3.12"
+	type = "g6-standard-1"
+	region = "us-east"
+}
+
+data "linode_networking_ips" "foobar" {
+	filter {
+		name = "region"
+		values = [linode_instance.foobar.region]
+	}
+}`, label)
+}
@@ -46,6 +46,34 @@ func TestAccDataSourceLinodeNodeBalancerConfig_basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceLinodeNodeBalancerConfig_ssl(t *testing.T) {
+	t.Parallel()
+
+	resName := "data.linode_nodebalancer_config.foofig"
+	nodebalancerName := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeNodeBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceLinodeNodeBalancerConfigSSL(nodebalancerName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLinodeNodeBalancerConfigExists,
+					resource.TestCheckResourceAttr(resName, "protocol", string(linodego.ProtocolHTTPS)),
+					resource.TestCheckResourceAttrPair(
+						resName, "ssl_commonname", "linode_nodebalancer_config.foofig", "ssl_commonname"),
+					resource.TestCheckResourceAttrPair(
+						resName, "ssl_fingerprint", "linode_nodebalancer_config.foofig", "ssl_fingerprint"),
+					resource.TestCheckResourceAttrSet(resName, "ssl_commonname"),
+					resource.TestCheckResourceAttrSet(resName, "ssl_fingerprint"),
+				),
+			},
+		},
+	})
+}
+
 func testDataSourceLinodeNodeBalancerConfigBasic(nodeBalancerName string) string {
 	return testAccCheckLinodeNodeBalancerConfigBasic(nodeBalancerName) + `
 data "linode_nodebalancer_config" "foofig" {
@@ -54,3 +82,12 @@ data "linode_nodebalancer_config" "foofig" {
 }
 `
 }
+
+func testDataSourceLinodeNodeBalancerConfigSSL(nodeBalancerName string) string {
+	return testAccCheckLinodeNodeBalancerConfigSSL(nodeBalancerName) + `
+data "linode_nodebalancer_config" "foofig" {
+	id = "${linode_nodebalancer_config.foofig.id}"
+	nodebalancer_id = "${linode_nodebalancer.foobar.id}"
+}
+`
+}
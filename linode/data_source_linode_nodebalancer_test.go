@@ -34,7 +34,7 @@ func TestAccDataSourceLinodeNodeBalancer_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resName, "transfer.0.out"),
 					resource.TestCheckResourceAttrSet(resName, "transfer.0.total"),
 					resource.TestCheckResourceAttr(resName, "tags.#", "1"),
-					resource.TestCheckResourceAttr(resName, "tags.0", "tf_test"),
+					resource.TestCheckTypeSetElemAttr(resName, "tags.*", "tf_test"),
 				),
 			},
 		},
@@ -42,7 +42,7 @@ func dataSourceLinodeSSHKeyRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error SSH Key label is required")
 	}
 
-	sshkeys, err := client.ListSSHKeys(context.Background(), nil)
+	sshkeys, err := client.ListSSHKeys(context.Background(), newListOptions(meta, ""))
 	var sshkey linodego.SSHKey
 	if err != nil {
 		return fmt.Errorf("Error listing sshkey: %s", err)
@@ -50,7 +50,7 @@ func dataSourceLinodeUserRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error User username is required")
 	}
 
-	users, err := client.ListUsers(context.Background(), nil)
+	users, err := client.ListUsers(context.Background(), newListOptions(meta, ""))
 	var user linodego.User
 	if err != nil {
 		return fmt.Errorf("Error listing user: %s", err)
@@ -60,19 +60,22 @@ func dataSourceLinodeVLANsRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("failed to construct filter: %s", err)
 	}
 
-	vlans, err := client.ListVLANs(context.Background(), &linodego.ListOptions{
-		Filter: filter,
-	})
+	vlans, err := client.ListVLANs(context.Background(), newListOptions(meta, filter))
 
 	if err != nil {
 		return fmt.Errorf("failed to list linode vlans: %s", err)
 	}
 
-	vlansFlattened := make([]interface{}, len(vlans))
+	vlansFlattened := make([]map[string]interface{}, len(vlans))
 	for i, vlan := range vlans {
 		vlansFlattened[i] = flattenLinodeVLAN(&vlan)
 	}
 
+	vlansFlattened, err = filterResults(d, vlansFlattened)
+	if err != nil {
+		return fmt.Errorf("failed to filter linode vlans: %s", err)
+	}
+
 	d.SetId(filter)
 	d.Set("vlans", vlansFlattened)
 
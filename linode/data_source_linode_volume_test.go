@@ -23,7 +23,7 @@ func TestAccDataSourceLinodeVolume_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "region", "us-west"),
 					resource.TestCheckResourceAttr(resourceName, "size", "20"),
 					resource.TestCheckResourceAttr(resourceName, "label", volumeName),
-					resource.TestCheckResourceAttr(resourceName, "tags.0", "tf_test"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "tags.*", "tf_test"),
 					resource.TestCheckResourceAttr(resourceName, "linode_id", "0"),
 					resource.TestCheckResourceAttrSet(resourceName, "created"),
 					resource.TestCheckResourceAttrSet(resourceName, "updated"),
@@ -5,6 +5,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// filterTagsName is the filter name used to match entities by tag. Tag values are never
+// passed to a data source's filterTypeFunc. Only linode_instances accepts it, as the image
+// and VLAN objects returned by the API have no tags.
+const filterTagsName = "tags"
+
+// The match_by modes supported by filter blocks. Only exact filters are sent to the
+// Linode API; the others are evaluated client-side against the listed results.
+const (
+	filterMatchExact     = "exact"
+	filterMatchSubstring = "substring"
+	filterMatchRegex     = "re"
 )
 
 // filterTypeFunc is a function that takes in a filter name and value,
@@ -31,6 +47,15 @@ func filterSchema(validFilters []string) *schema.Schema {
 					Description: "The value(s) to be used in the filter.",
 					Required:    true,
 				},
+				"match_by": {
+					Type: schema.TypeString,
+					Description: "The method to match the field by. Substring and regular expression matches are " +
+						"evaluated after the results are listed from the API.",
+					Optional: true,
+					Default:  filterMatchExact,
+					ValidateFunc: validation.StringInSlice(
+						[]string{filterMatchExact, filterMatchSubstring, filterMatchRegex}, false),
+				},
 			},
 		},
 	}
@@ -41,22 +66,22 @@ func constructFilterString(d *schema.ResourceData, typeFunc filterTypeFunc) (str
 	filters := d.Get("filter").([]interface{})
 	resultMap := make(map[string]interface{})
 
-	if len(filters) < 1 {
-		return "{}", nil
-	}
-
 	var rootFilter []interface{}
 
 	for _, filter := range filters {
 		filter := filter.(map[string]interface{})
 
+		if filter["match_by"].(string) != filterMatchExact {
+			continue
+		}
+
 		name := filter["name"].(string)
 		values := filter["values"].([]interface{})
 
 		subFilter := make([]interface{}, len(values))
 
 		for i, value := range values {
-			value, err := typeFunc(name, value.(string))
+			value, err := filterValueToType(name, value.(string), typeFunc)
 			if err != nil {
 				return "", err
 			}
@@ -72,6 +97,10 @@ func constructFilterString(d *schema.ResourceData, typeFunc filterTypeFunc) (str
 		})
 	}
 
+	if len(rootFilter) < 1 {
+		return "{}", nil
+	}
+
 	resultMap["+and"] = rootFilter
 
 	result, err := json.Marshal(resultMap)
@@ -81,3 +110,103 @@ func constructFilterString(d *schema.ResourceData, typeFunc filterTypeFunc) (str
 
 	return string(result), nil
 }
+
+// filterValueToType converts a filter value using typeFunc. Tags are always matched
+// by their string value, so they are not passed to typeFunc.
+func filterValueToType(filterName, value string, typeFunc filterTypeFunc) (interface{}, error) {
+	if filterName == filterTagsName {
+		return value, nil
+	}
+
+	return typeFunc(filterName, value)
+}
+
+// filterResults applies the substring and regular expression filters to the given
+// flattened results. Each result must be keyed by the filter names.
+func filterResults(d *schema.ResourceData, results []map[string]interface{}) ([]map[string]interface{}, error) {
+	return applyFilters(d, results, false)
+}
+
+// filterResultsLocally applies every filter, including exact ones, to the given flattened
+// results. It is used by data sources whose endpoints do not accept filters.
+func filterResultsLocally(d *schema.ResourceData, results []map[string]interface{}) ([]map[string]interface{}, error) {
+	return applyFilters(d, results, true)
+}
+
+func applyFilters(
+	d *schema.ResourceData, results []map[string]interface{}, includeExact bool,
+) ([]map[string]interface{}, error) {
+	for _, filter := range d.Get("filter").([]interface{}) {
+		filter := filter.(map[string]interface{})
+
+		matchBy := filter["match_by"].(string)
+		if matchBy == filterMatchExact && !includeExact {
+			continue
+		}
+
+		name := filter["name"].(string)
+		values := expandStringList(filter["values"].([]interface{}))
+
+		var regexes []*regexp.Regexp
+		if matchBy == filterMatchRegex {
+			for _, value := range values {
+				re, err := regexp.Compile(value)
+				if err != nil {
+					return nil, fmt.Errorf("invalid regular expression %q for filter %s: %s", value, name, err)
+				}
+				regexes = append(regexes, re)
+			}
+		}
+
+		filtered := make([]map[string]interface{}, 0, len(results))
+		for _, result := range results {
+			if filterResultMatches(result[name], matchBy, values, regexes) {
+				filtered = append(filtered, result)
+			}
+		}
+		results = filtered
+	}
+
+	return results, nil
+}
+
+// filterResultMatches returns whether the given field matches any of the values, either
+// exactly, as a regular expression if regexes are given, or as a case-insensitive substring.
+func filterResultMatches(field interface{}, matchBy string, values []string, regexes []*regexp.Regexp) bool {
+	var fieldValues []string
+
+	switch field := field.(type) {
+	case nil:
+		return false
+	case []string:
+		fieldValues = field
+	case []interface{}:
+		for _, v := range field {
+			fieldValues = append(fieldValues, fmt.Sprint(v))
+		}
+	default:
+		fieldValues = []string{fmt.Sprint(field)}
+	}
+
+	for _, fieldValue := range fieldValues {
+		if regexes != nil {
+			for _, re := range regexes {
+				if re.MatchString(fieldValue) {
+					return true
+				}
+			}
+			continue
+		}
+
+		for _, value := range values {
+			if matchBy == filterMatchExact && fieldValue == value {
+				return true
+			}
+			if matchBy == filterMatchSubstring && strings.Contains(strings.ToLower(fieldValue), strings.ToLower(value)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
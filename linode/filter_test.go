@@ -0,0 +1,104 @@
+package linode
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestConstructFilterString_tags(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"filter": filterSchema([]string{"id", filterTagsName}),
+	}, map[string]interface{}{
+		"filter": []interface{}{
+			map[string]interface{}{"name": "id", "values": []interface{}{"123"}},
+			map[string]interface{}{"name": "tags", "values": []interface{}{"123", "prod"}},
+		},
+	})
+
+	filter, err := constructFilterString(d, func(filterName, value string) (interface{}, error) {
+		if filterName == "tags" {
+			t.Fatalf("tags filter values should not be converted")
+		}
+		return strconv.Atoi(value)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"+and":[{"+or":[{"id":123}]},{"+or":[{"tags":"123"},{"tags":"prod"}]}]}`
+	if filter != expected {
+		t.Fatalf("expected %s, got %s", expected, filter)
+	}
+}
+
+func TestFilterResults_matchBy(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"filter": filterSchema([]string{"label", filterTagsName}),
+	}, map[string]interface{}{
+		"filter": []interface{}{
+			map[string]interface{}{"name": "label", "values": []interface{}{"WEB"}, "match_by": "substring"},
+			map[string]interface{}{"name": "tags", "values": []interface{}{"^prod-"}, "match_by": "re"},
+		},
+	})
+
+	results, err := filterResults(d, []map[string]interface{}{
+		{"label": "web-1", "tags": []string{"prod-east"}},
+		{"label": "web-2", "tags": []string{"staging"}},
+		{"label": "db-1", "tags": []string{"prod-east"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results) != 1 || results[0]["label"] != "web-1" {
+		t.Fatalf("expected only web-1 to match, got %v", results)
+	}
+
+	filter, err := constructFilterString(d, func(_, value string) (interface{}, error) {
+		return value, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if filter != "{}" {
+		t.Fatalf("expected client-side filters to be excluded from the API filter, got %s", filter)
+	}
+}
+
+func TestFilterResultsLocally_exact(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"filter": filterSchema([]string{"region"}),
+	}, map[string]interface{}{
+		"filter": []interface{}{
+			map[string]interface{}{"name": "region", "values": []interface{}{"us-east", "eu-west"}},
+		},
+	})
+
+	results := []map[string]interface{}{
+		{"region": "us-east"},
+		{"region": "us-east-1"},
+		{"region": "eu-west"},
+		{"range": "2600:3c00::/64"},
+	}
+
+	local, err := filterResultsLocally(d, results)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(local) != 2 || local[0]["region"] != "us-east" || local[1]["region"] != "eu-west" {
+		t.Fatalf("expected only exact region matches, got %v", local)
+	}
+
+	remote, err := filterResults(d, results)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(remote) != len(results) {
+		t.Fatalf("expected exact filters to be left to the API, got %v", remote)
+	}
+}
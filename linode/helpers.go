@@ -1,6 +1,28 @@
 package linode
 
-import "sync"
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/linode/linodego"
+)
+
+// createdResourceNotFoundTimeout is the amount of time a freshly created
+// resource may 404 before it is considered missing.
+const createdResourceNotFoundTimeout = 10 * time.Second
+
+// newListOptions returns the options for listing every page of resources matching filter, using the
+// list_page_size of the provider.
+func newListOptions(meta interface{}, filter string) *linodego.ListOptions {
+	opts := linodego.NewListOptions(0, filter)
+	opts.PageSize = meta.(*ProviderMeta).Config.ListPageSize
+	return opts
+}
 
 // waitGroupCh creates a new readonly struct channel that is signaled when
 // the underlying sync.WaitGroup channel reaches 0.
@@ -12,3 +34,130 @@ func waitGroupCh(wg *sync.WaitGroup) <-chan struct{} {
 	}()
 	return done
 }
+
+// isLinodeNotFound returns true if err is a Linode API 404 error.
+func isLinodeNotFound(err error) bool {
+	lerr, ok := err.(*linodego.Error)
+	return ok && lerr.Code == 404
+}
+
+// retryOnNotFound calls get until it returns something other than a 404 or the
+// timeout is reached. This works around eventual consistency in the API where a
+// resource may not be readable immediately after it is created.
+func retryOnNotFound(ctx context.Context, timeout time.Duration, get func(ctx context.Context) error) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		err := get(ctx)
+		if !isLinodeNotFound(err) {
+			return err
+		}
+		log.Printf("[DEBUG] freshly created resource not found, retrying: %s", err)
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return err
+		}
+	}
+}
+
+// retryOnNotFoundIfNew calls get through retryOnNotFound when d was created during
+// this apply, and calls it once otherwise. It is meant to wrap the fetch at the top
+// of a Read so that the read following a Create tolerates eventual consistency.
+func retryOnNotFoundIfNew(ctx context.Context, d *schema.ResourceData, get func(ctx context.Context) error) error {
+	if d.IsNewResource() {
+		return retryOnNotFound(ctx, createdResourceNotFoundTimeout, get)
+	}
+	return get(ctx)
+}
+
+// formatLinodeError formats an error returned by the Linode API so that each
+// rejected field is reported alongside its reason, e.g.
+// "label: Label must be between 3 and 32 characters". Errors that did not
+// originate from an API response are returned unchanged.
+func formatLinodeError(err error) string {
+	lerr, ok := err.(*linodego.Error)
+	if !ok || lerr.Response == nil {
+		return err.Error()
+	}
+
+	reasons := strings.Split(lerr.Message, "; ")
+	for i, reason := range reasons {
+		if !strings.HasPrefix(reason, "[") {
+			continue
+		}
+		if end := strings.Index(reason, "] "); end > 0 {
+			reasons[i] = fmt.Sprintf("%s: %s", reason[1:end], reason[end+2:])
+		}
+	}
+	return fmt.Sprintf("%s (HTTP %d)", strings.Join(reasons, "; "), lerr.Code)
+}
+
+// updateResourceTags replaces the oldTags of the given entity with newTags through the entity's
+// own update endpoint, as the Linode API can not remove a tag from a single entity through its
+// tags endpoints. Nothing is sent when no tag is added or removed. The update only carries the
+// tags, so it can be made alongside other updates.
+func updateResourceTags(
+	ctx context.Context, client linodego.Client, entityType linodego.EntityType, id int, oldTags, newTags []string,
+) error {
+	added, removed := diffResourceTags(oldTags, newTags)
+	if len(added) == 0 && len(removed) == 0 {
+		return nil
+	}
+	log.Printf("[DEBUG] Updating the tags of %s %d: adding %v, removing %v", entityType, id, added, removed)
+
+	// The tags are never nil, so removing every tag sends an empty list rather than null.
+	tags := append([]string{}, newTags...)
+
+	var err error
+	switch entityType {
+	case linodego.EntityLinode:
+		_, err = client.UpdateInstance(ctx, id, linodego.InstanceUpdateOptions{Tags: &tags})
+	case entityVolume:
+		_, err = client.UpdateVolume(ctx, id, linodego.VolumeUpdateOptions{Tags: &tags})
+	case linodego.EntityNodebalancer:
+		_, err = client.UpdateNodeBalancer(ctx, id, linodego.NodeBalancerUpdateOptions{Tags: &tags})
+	case linodego.EntityFirewall:
+		_, err = client.UpdateFirewall(ctx, id, linodego.FirewallUpdateOptions{Tags: &tags})
+	case linodego.EntityDomain:
+		// DomainUpdateOptions always sends the master and AXFR IPs, which would clear them.
+		resp, putErr := client.R(ctx).SetBody(map[string][]string{"tags": tags}).Put(fmt.Sprintf("domains/%d", id))
+		if err = putErr; err == nil && resp.IsError() {
+			err = linodego.NewError(resp)
+		}
+	default:
+		return fmt.Errorf("tags of %s entities can not be updated", entityType)
+	}
+
+	if err != nil {
+		return fmt.Errorf("Error updating the tags of %s %d: %s", entityType, id, formatLinodeError(err))
+	}
+	return nil
+}
+
+// diffResourceTags returns the tags of newTags that are not in oldTags and the tags of oldTags
+// that are not in newTags.
+func diffResourceTags(oldTags, newTags []string) (added, removed []string) {
+	oldSet := make(map[string]bool, len(oldTags))
+	for _, tag := range oldTags {
+		oldSet[tag] = true
+	}
+	newSet := make(map[string]bool, len(newTags))
+	for _, tag := range newTags {
+		newSet[tag] = true
+		if !oldSet[tag] {
+			added = append(added, tag)
+		}
+	}
+	for _, tag := range oldTags {
+		if !newSet[tag] {
+			removed = append(removed, tag)
+		}
+	}
+	return added, removed
+}
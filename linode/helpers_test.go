@@ -1,7 +1,12 @@
 package linode
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strconv"
 	"strings"
@@ -9,7 +14,9 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/linode/linodego"
 	"golang.org/x/crypto/ssh"
 )
 
@@ -107,3 +114,123 @@ func testAccCheckResourceNonEmptyList(resourceName, attrName string) resource.Te
 		return nil
 	}
 }
+
+func TestFormatLinodeError(t *testing.T) {
+	for _, tc := range []struct {
+		err      error
+		expected string
+	}{
+		{
+			err: &linodego.Error{
+				Code:     400,
+				Message:  "[label] Label must be between 3 and 32 characters; [region] region is not valid",
+				Response: &http.Response{},
+			},
+			expected: "label: Label must be between 3 and 32 characters; region: region is not valid (HTTP 400)",
+		},
+		{
+			err:      &linodego.Error{Code: 400, Message: "Bad request", Response: &http.Response{}},
+			expected: "Bad request (HTTP 400)",
+		},
+		{
+			err:      &linodego.Error{Code: linodego.ErrorFromString, Message: "something went wrong"},
+			expected: "[001] something went wrong",
+		},
+		{
+			err:      errors.New("plain error"),
+			expected: "plain error",
+		},
+	} {
+		if got := formatLinodeError(tc.err); got != tc.expected {
+			t.Errorf("expected %q, got %q", tc.expected, got)
+		}
+	}
+}
+
+func TestRetryOnNotFoundIfNew(t *testing.T) {
+	notFound := &linodego.Error{Code: http.StatusNotFound, Message: "Not found"}
+	resourceSchema := map[string]*schema.Schema{"label": {Type: schema.TypeString, Optional: true}}
+
+	calls := 0
+	get := func(ctx context.Context) error {
+		calls++
+		if calls == 1 {
+			return notFound
+		}
+		return nil
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+	if err := retryOnNotFoundIfNew(context.Background(), d, get); !isLinodeNotFound(err) || calls != 1 {
+		t.Fatalf("expected a single call returning 404 for an existing resource, got %v after %d calls", err, calls)
+	}
+
+	calls = 0
+	d.MarkNewResource()
+	if err := retryOnNotFoundIfNew(context.Background(), d, get); err != nil || calls != 2 {
+		t.Fatalf("expected a new resource to retry past a 404, got %v after %d calls", err, calls)
+	}
+}
+
+func TestNewListOptions(t *testing.T) {
+	meta := &ProviderMeta{Config: &Config{ListPageSize: 500}}
+
+	opts := newListOptions(meta, `{"label": "foo"}`)
+	if opts.Page != 0 {
+		t.Fatalf("expected every page to be listed, got page %d", opts.Page)
+	}
+	if opts.PageSize != 500 {
+		t.Fatalf("expected a page size of 500, got %d", opts.PageSize)
+	}
+	if opts.Filter != `{"label": "foo"}` {
+		t.Fatalf("expected the filter to be kept, got %q", opts.Filter)
+	}
+}
+
+func TestUpdateResourceTags(t *testing.T) {
+	var path, body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		path, body = r.URL.Path, string(b)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	client := linodego.NewClient(server.Client())
+	client.SetBaseURL(server.URL)
+
+	for _, tc := range []struct {
+		entityType linodego.EntityType
+		oldTags    []string
+		newTags    []string
+		path       string
+		body       string
+	}{
+		{linodego.EntityLinode, nil, []string{"a", "b"}, "/linode/instances/1", `{"tags":["a","b"]}`},
+		{linodego.EntityLinode, []string{"a"}, nil, "/linode/instances/1", `{"tags":[]}`},
+		{entityVolume, []string{"b"}, []string{"a"}, "/volumes/1", `{"tags":["a"]}`},
+		{linodego.EntityNodebalancer, []string{"a"}, []string{}, "/nodebalancers/1", `{"tags":[]}`},
+		{linodego.EntityFirewall, nil, []string{"a"}, "/networking/firewalls/1", `{"tags":["a"]}`},
+		{linodego.EntityDomain, []string{"a", "b"}, []string{"b"}, "/domains/1", `{"tags":["b"]}`},
+	} {
+		path, body = "", ""
+		err := updateResourceTags(context.Background(), client, tc.entityType, 1, tc.oldTags, tc.newTags)
+		if err != nil {
+			t.Fatalf("expected the tags of %s to be updated, got %s", tc.entityType, err)
+		}
+		if path != tc.path || body != tc.body {
+			t.Errorf("expected %s %s for %s %v, got %s %s", tc.path, tc.body, tc.entityType, tc.newTags, path, body)
+		}
+	}
+
+	path = ""
+	err := updateResourceTags(context.Background(), client, linodego.EntityLinode, 1, []string{"a", "b"}, []string{"b", "a"})
+	if err != nil || path != "" {
+		t.Errorf("expected unchanged tags not to be sent, got %s %v", path, err)
+	}
+
+	if err := updateResourceTags(context.Background(), client, linodego.EntityDisk, 1, nil, []string{"a"}); err == nil {
+		t.Error("expected an error updating the tags of a disk")
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"regexp"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -19,6 +21,8 @@ import (
 var (
 	boolFalse = false
 	boolTrue  = true
+
+	instanceConfigDeviceSlotRegex = regexp.MustCompile(`^/dev/sd[a-h]$`)
 )
 
 type flattenedProfileReferrals map[string]interface{}
@@ -54,6 +58,17 @@ func flattenInstanceSpecs(instance linodego.Instance) []map[string]int {
 	}}
 }
 
+func flattenInstancePrice(typ *linodego.LinodeType) []map[string]interface{} {
+	if typ == nil || typ.Price == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{{
+		"hourly":  typ.Price.Hourly,
+		"monthly": typ.Price.Monthly,
+	}}
+}
+
 func flattenInstanceAlerts(instance linodego.Instance) []map[string]int {
 	return []map[string]int{{
 		"cpu":            instance.Alerts.CPU,
@@ -64,6 +79,32 @@ func flattenInstanceAlerts(instance linodego.Instance) []map[string]int {
 	}}
 }
 
+// expandInstanceAlerts builds the alert thresholds for an instance, keeping the
+// current value of any threshold that is not specified in the configuration.
+// A threshold explicitly set to 0 disables that alert.
+func expandInstanceAlerts(d *schema.ResourceData, current *linodego.InstanceAlert) *linodego.InstanceAlert {
+	alerts := linodego.InstanceAlert{}
+	if current != nil {
+		alerts = *current
+	}
+
+	thresholds := map[string]*int{
+		"cpu":            &alerts.CPU,
+		"io":             &alerts.IO,
+		"network_in":     &alerts.NetworkIn,
+		"network_out":    &alerts.NetworkOut,
+		"transfer_quota": &alerts.TransferQuota,
+	}
+
+	for name, threshold := range thresholds {
+		// GetOkExists is needed to distinguish an explicit 0 (disabled) from an unset threshold
+		if value, ok := d.GetOkExists("alerts.0." + name); ok {
+			*threshold = value.(int)
+		}
+	}
+	return &alerts
+}
+
 func flattenInstanceBackups(instance linodego.Instance) []map[string]interface{} {
 	return []map[string]interface{}{{
 		"enabled": instance.Backups.Enabled,
@@ -74,22 +115,115 @@ func flattenInstanceBackups(instance linodego.Instance) []map[string]interface{}
 	}}
 }
 
-func flattenInstanceDisks(instanceDisks []linodego.InstanceDisk) (disks []map[string]interface{}, swapSize int) {
+func flattenInstanceDisks(
+	instanceDisks []linodego.InstanceDisk, filesystemPaths map[int]string,
+) (disks []map[string]interface{}, swapSize int) {
 	for _, disk := range instanceDisks {
 		// Determine if swap exists and the size.  If it does not exist, swap_size=0
 		if disk.Filesystem == "swap" {
 			swapSize += disk.Size
 		}
 		disks = append(disks, map[string]interface{}{
-			"id":         disk.ID,
-			"size":       disk.Size,
-			"label":      disk.Label,
-			"filesystem": string(disk.Filesystem),
+			"id":              disk.ID,
+			"size":            disk.Size,
+			"label":           disk.Label,
+			"filesystem":      string(disk.Filesystem),
+			"filesystem_path": filesystemPaths[disk.ID],
 		})
 	}
 	return
 }
 
+// flattenInstanceDiskFilesystemPaths maps the label of each disk with a filesystem path to that path.
+func flattenInstanceDiskFilesystemPaths(
+	instanceDisks []linodego.InstanceDisk, filesystemPaths map[int]string,
+) map[string]string {
+	paths := make(map[string]string, len(filesystemPaths))
+	for _, disk := range instanceDisks {
+		if path, ok := filesystemPaths[disk.ID]; ok {
+			paths[disk.Label] = path
+		}
+	}
+	return paths
+}
+
+// getInstanceDiskFilesystemPaths maps the ID of each disk attached to a config to the device path (/dev/sdX)
+// it is attached at. Disks attached to the config labeled bootConfigLabel take precedence, followed by the
+// remaining configs in order.
+func getInstanceDiskFilesystemPaths(configs []linodego.InstanceConfig, bootConfigLabel string) map[int]string {
+	ordered := make([]linodego.InstanceConfig, 0, len(configs))
+	for _, config := range configs {
+		if config.Label == bootConfigLabel {
+			ordered = append([]linodego.InstanceConfig{config}, ordered...)
+		} else {
+			ordered = append(ordered, config)
+		}
+	}
+
+	paths := make(map[int]string)
+	for _, config := range ordered {
+		if config.Devices == nil {
+			continue
+		}
+
+		for _, device := range []struct {
+			slot   string
+			device *linodego.InstanceConfigDevice
+		}{
+			{"sda", config.Devices.SDA}, {"sdb", config.Devices.SDB}, {"sdc", config.Devices.SDC},
+			{"sdd", config.Devices.SDD}, {"sde", config.Devices.SDE}, {"sdf", config.Devices.SDF},
+			{"sdg", config.Devices.SDG}, {"sdh", config.Devices.SDH},
+		} {
+			if device.device == nil || device.device.DiskID == 0 {
+				continue
+			}
+			if _, ok := paths[device.device.DiskID]; !ok {
+				paths[device.device.DiskID] = "/dev/" + device.slot
+			}
+		}
+	}
+	return paths
+}
+
+// instanceDiskWriteOnlyFields are the disk fields that are only used when a disk is created and are not
+// returned by the API for existing disks.
+var instanceDiskWriteOnlyFields = []string{
+	"image", "authorized_keys", "authorized_users", "stackscript_id", "stackscript_data", "root_pass", "read_only",
+}
+
+// carryOverInstanceDiskWriteOnlyFields copies the write-only fields of each disk in state to the flattened
+// disk with the same label, as the API does not return them.
+func carryOverInstanceDiskWriteOnlyFields(disks []map[string]interface{}, stateDisks []interface{}) {
+	stateDisksByLabel := make(map[string]map[string]interface{}, len(stateDisks))
+	for _, stateDisk := range stateDisks {
+		if stateDisk, ok := stateDisk.(map[string]interface{}); ok {
+			stateDisksByLabel[stateDisk["label"].(string)] = stateDisk
+		}
+	}
+
+	for _, disk := range disks {
+		stateDisk, ok := stateDisksByLabel[disk["label"].(string)]
+		if !ok {
+			continue
+		}
+		for _, field := range instanceDiskWriteOnlyFields {
+			if value, ok := stateDisk[field]; ok {
+				disk[field] = value
+			}
+		}
+	}
+}
+
+// suppressInstanceDiskWriteOnlyDiff ignores changes to a write-only disk field unless the label of that
+// disk changes, which means a different disk is being created.
+func suppressInstanceDiskWriteOnlyDiff(k, old, new string, d *schema.ResourceData) bool {
+	parts := strings.SplitN(k, ".", 3)
+	if len(parts) < 3 {
+		return false
+	}
+	return !d.HasChange(parts[0] + "." + parts[1] + ".label")
+}
+
 func flattenInstanceConfigs(
 	instanceConfigs []linodego.InstanceConfig,
 	diskLabelIDMap map[int]string,
@@ -155,26 +289,7 @@ func createInstanceConfigsFromSet(
 		configOpts.Comments = config["comments"].(string)
 
 		if helpers, ok := config["helpers"].([]interface{}); ok {
-			for _, helper := range helpers {
-				if helperMap, helperMapOk := helper.(map[string]interface{}); helperMapOk {
-					configOpts.Helpers = &linodego.InstanceConfigHelpers{}
-					if updateDBDisabled, ok := helperMap["updatedb_disabled"]; ok {
-						configOpts.Helpers.UpdateDBDisabled = updateDBDisabled.(bool)
-					}
-					if distro, ok := helperMap["distro"]; ok {
-						configOpts.Helpers.Distro = distro.(bool)
-					}
-					if modulesDep, ok := helperMap["modules_dep"]; ok {
-						configOpts.Helpers.ModulesDep = modulesDep.(bool)
-					}
-					if network, ok := helperMap["network"]; ok {
-						configOpts.Helpers.Network = network.(bool)
-					}
-					if devTmpFsAutomount, ok := helperMap["devtmpfs_automount"]; ok {
-						configOpts.Helpers.DevTmpFsAutomount = devTmpFsAutomount.(bool)
-					}
-				}
-			}
+			configOpts.Helpers = expandInstanceConfigHelpers(helpers)
 		}
 
 		if interfaces, ok := config["interface"]; ok {
@@ -211,7 +326,7 @@ func createInstanceConfigsFromSet(
 
 		instanceConfig, err := client.CreateInstanceConfig(ctx, instanceID, configOpts)
 		if err != nil {
-			return configIDMap, fmt.Errorf("Error creating Instance Config: %s", err)
+			return configIDMap, fmt.Errorf("Error creating Instance Config: %s", formatLinodeError(err))
 		}
 		configIDMap[instanceConfig.ID] = *instanceConfig
 	}
@@ -268,17 +383,8 @@ func updateInstanceConfigs(
 			configUpdateOpts.Comments = tfc["comments"].(string)
 			configUpdateOpts.MemoryLimit = tfc["memory_limit"].(int)
 
-			tfcHelpersRaw, helpersFound := tfc["helpers"]
-			if tfcHelpers, ok := tfcHelpersRaw.([]interface{}); helpersFound && ok {
-				helpersMap := tfcHelpers[0].(map[string]interface{})
-				configUpdateOpts.Helpers = &linodego.InstanceConfigHelpers{
-					UpdateDBDisabled:  helpersMap["updatedb_disabled"].(bool),
-					Distro:            helpersMap["distro"].(bool),
-					ModulesDep:        helpersMap["modules_dep"].(bool),
-					Network:           helpersMap["network"].(bool),
-					DevTmpFsAutomount: helpersMap["devtmpfs_automount"].(bool),
-				}
-
+			if tfcHelpers, ok := tfc["helpers"].([]interface{}); ok && len(tfcHelpers) > 0 {
+				configUpdateOpts.Helpers = expandInstanceConfigHelpers(tfcHelpers)
 			}
 
 			configUpdateOpts.Interfaces = make([]linodego.InstanceConfigInterface, 0)
@@ -320,7 +426,7 @@ func updateInstanceConfigs(
 			updatedConfig, err := client.UpdateInstanceConfig(ctx, instance.ID, existingConfig.ID, configUpdateOpts)
 			if err != nil {
 				return rebootInstance, updatedConfigMap, updatedConfigs, fmt.Errorf(
-					"Error updating Instance %d Config %d: %s", instance.ID, existingConfig.ID, err)
+					"Error updating Instance %d Config %d: %s", instance.ID, existingConfig.ID, formatLinodeError(err))
 			}
 
 			updatedConfigMap[updatedConfig.Label] = updatedConfig.ID
@@ -496,6 +602,78 @@ func expandInstanceConfigDevice(m map[string]interface{}) *linodego.InstanceConf
 	return dev
 }
 
+// instanceDiskCreateConcurrency bounds how many disks of a new instance are created at once.
+const instanceDiskCreateConcurrency = 4
+
+// createInstanceDisks creates the disks of a new instance concurrently and waits for all of them to be ready.
+// Every failed disk is reported in the returned error.
+//
+// returns a map of the created disk IDs indexed by label.
+func createInstanceDisks(
+	ctx context.Context,
+	client linodego.Client,
+	instance linodego.Instance,
+	diskSpecs []interface{},
+	d *schema.ResourceData,
+) (map[string]int, error) {
+	diskIDs := make([]int, len(diskSpecs))
+	errs := make([]error, len(diskSpecs))
+
+	sem := make(chan struct{}, instanceDiskCreateConcurrency)
+	var wg sync.WaitGroup
+	wg.Add(len(diskSpecs))
+
+	for i, spec := range diskSpecs {
+		go func(i int, disk diskSpec) {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			diskOpts, err := expandInstanceDiskCreateOptions(disk)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+
+			instanceDisk, err := client.CreateInstanceDisk(ctx, instance.ID, diskOpts)
+			if err != nil {
+				errs[i] = fmt.Errorf("Error creating Linode instance %d disk %q: %s",
+					instance.ID, diskOpts.Label, formatLinodeError(err))
+				return
+			}
+
+			// disk create events can not be told apart when several run on the same instance,
+			// so each disk is waited on by its own status instead
+			if _, err := client.WaitForInstanceDiskStatus(
+				ctx, instance.ID, instanceDisk.ID, linodego.DiskReady, getDeadlineSeconds(ctx, d),
+			); err != nil {
+				errs[i] = fmt.Errorf("Error waiting for Linode instance %d disk %q: %s", instance.ID, diskOpts.Label, err)
+				return
+			}
+			diskIDs[i] = instanceDisk.ID
+		}(i, spec.(map[string]interface{}))
+	}
+
+	wg.Wait()
+
+	var errStrs []string
+	for _, err := range errs {
+		if err != nil {
+			errStrs = append(errStrs, err.Error())
+		}
+	}
+	if len(errStrs) > 0 {
+		return nil, fmt.Errorf("Error creating disks: %s", strings.Join(errStrs, "; "))
+	}
+
+	diskIDLabelMap := make(map[string]int, len(diskSpecs))
+	for i, spec := range diskSpecs {
+		diskIDLabelMap[spec.(map[string]interface{})["label"].(string)] = diskIDs[i]
+	}
+	return diskIDLabelMap, nil
+}
+
 func createInstanceDisk(
 	ctx context.Context,
 	client linodego.Client,
@@ -503,12 +681,37 @@ func createInstanceDisk(
 	disk diskSpec,
 	d *schema.ResourceData,
 ) (*linodego.InstanceDisk, error) {
+	diskOpts, err := expandInstanceDiskCreateOptions(disk)
+	if err != nil {
+		return nil, err
+	}
+
+	instanceDisk, err := client.CreateInstanceDisk(ctx, instance.ID, diskOpts)
+	if err != nil {
+		return nil, fmt.Errorf("Error creating Linode instance %d disk: %s", instance.ID, formatLinodeError(err))
+	}
+
+	_, err = client.WaitForEventFinished(ctx, instance.ID, linodego.EntityLinode,
+		linodego.ActionDiskCreate, *instanceDisk.Created, getDeadlineSeconds(ctx, d))
+	if err != nil {
+		return nil, fmt.Errorf("Error waiting for Linode instance %d disk: %s", instanceDisk.ID, err)
+	}
+
+	return instanceDisk, err
+}
+
+// expandInstanceDiskCreateOptions converts a disk block into the options accepted by the API.
+func expandInstanceDiskCreateOptions(disk diskSpec) (linodego.InstanceDiskCreateOptions, error) {
 	diskOpts := linodego.InstanceDiskCreateOptions{
 		Label:      disk["label"].(string),
 		Filesystem: disk["filesystem"].(string),
 		Size:       disk["size"].(int),
 	}
 
+	if readOnly, ok := disk["read_only"]; ok {
+		diskOpts.ReadOnly = readOnly.(bool)
+	}
+
 	if image, ok := disk["image"]; ok {
 		diskOpts.Image = image.(string)
 
@@ -518,7 +721,7 @@ func createInstanceDisk(
 			var err error
 			diskOpts.RootPass, err = createRandomRootPassword()
 			if err != nil {
-				return nil, err
+				return diskOpts, err
 			}
 		}
 
@@ -541,7 +744,7 @@ func createInstanceDisk(
 		if stackscriptDataRaw, ok := disk["stackscript_data"]; ok {
 			stackscriptData, ok := stackscriptDataRaw.(map[string]interface{})
 			if !ok {
-				return nil, fmt.Errorf("Error parsing stackscript_data: expected map[string]interface{}")
+				return diskOpts, fmt.Errorf("Error parsing stackscript_data: expected map[string]interface{}")
 			}
 			diskOpts.StackscriptData = make(map[string]string, len(stackscriptData))
 			for name, value := range stackscriptData {
@@ -550,18 +753,7 @@ func createInstanceDisk(
 		}
 	}
 
-	instanceDisk, err := client.CreateInstanceDisk(ctx, instance.ID, diskOpts)
-	if err != nil {
-		return nil, fmt.Errorf("Error creating Linode instance %d disk: %s", instance.ID, err)
-	}
-
-	_, err = client.WaitForEventFinished(ctx, instance.ID, linodego.EntityLinode,
-		linodego.ActionDiskCreate, *instanceDisk.Created, getDeadlineSeconds(ctx, d))
-	if err != nil {
-		return nil, fmt.Errorf("Error waiting for Linode instance %d disk: %s", instanceDisk.ID, err)
-	}
-
-	return instanceDisk, err
+	return diskOpts, nil
 }
 
 // getInstanceDisks returns a map of disks for a given instance that is indexed by label.
@@ -786,14 +978,14 @@ func changeInstanceType(
 		return nil, err
 	}
 
-	diskResize := false
+	diskResize := d.Get("allow_auto_disk_resize").(bool)
 	resizeOpts := linodego.InstanceResizeOptions{
 		AllowAutoDiskResize: &diskResize,
 		Type:                targetType,
 	}
 
 	if err := client.ResizeInstance(ctx, instance.ID, resizeOpts); err != nil {
-		return nil, fmt.Errorf("Error resizing Instance %d: %s", instance.ID, err)
+		return nil, fmt.Errorf("Error resizing Instance %d: %s", instance.ID, formatLinodeError(err))
 	}
 	_, err = client.WaitForEventFinished(ctx, instance.ID, linodego.EntityLinode, linodego.ActionLinodeResize,
 		*instance.Created, getDeadlineSeconds(ctx, d))
@@ -924,124 +1116,517 @@ func getInstanceDefaultDisks(
 	return
 }
 
-// getInstanceTypeChange checks to see if the linode itself was resized.
-func getInstanceTypeChange(
+// resizeInstanceRootDisk resizes the implicit root disk of a newly created, unbooted instance once its disks have
+// been deployed, leaving the remaining space of the instance type unallocated.
+func resizeInstanceRootDisk(
 	ctx context.Context,
+	client linodego.Client,
+	instance linodego.Instance,
+	size int,
 	d *schema.ResourceData,
-	client *linodego.Client,
-) (oldSpec, newSpec *linodego.LinodeType, err error) {
-	old, new := d.GetChange("type")
-	oldType, newType := old.(string), new.(string)
+) error {
+	if _, err := client.WaitForEventFinished(ctx, instance.ID, linodego.EntityLinode, linodego.ActionLinodeCreate,
+		*instance.Created, getDeadlineSeconds(ctx, d)); err != nil {
+		return fmt.Errorf("Error waiting for Instance %d to finish creating: %s", instance.ID, err)
+	}
 
-	oldSpec, err = client.GetType(ctx, oldType)
+	created, err := client.WaitForInstanceStatus(
+		ctx, instance.ID, linodego.InstanceOffline, getDeadlineSeconds(ctx, d))
 	if err != nil {
-		return
+		return fmt.Errorf("Error waiting for Instance %d to go offline: %s", instance.ID, err)
 	}
-	newSpec, err = client.GetType(ctx, newType)
+
+	bootDisk, _, err := getInstanceDefaultDisks(ctx, instance.ID, &client)
 	if err != nil {
-		return
+		return err
 	}
-	return
+	if bootDisk == nil {
+		return fmt.Errorf("Error resizing root disk of Instance %d: no root disk found", instance.ID)
+	}
+	if bootDisk.Size == size {
+		return nil
+	}
+
+	return changeInstanceDiskSize(ctx, &client, *created, *bootDisk, size, d)
 }
 
-// applyInstanceDiskSpec checks to see if the staged disk changes can be supported by the instance specification's
-// capacity. If there is sufficient space, it attempts to update the disks.
-//
-// returns bool describing whether change has occurred.
-func applyInstanceDiskSpec(
-	ctx context.Context,
-	d *schema.ResourceData,
-	client *linodego.Client,
-	instance *linodego.Instance,
-	typ *linodego.LinodeType,
-) (bool, error) {
-	if err := assertDiskConfigFitsInstanceType(d, typ); err != nil {
-		return false, err
+// applyInstanceDesiredState boots or shuts down an instance until its status matches the desired_state of the
+// resource. Instances in the middle of a power transition are waited on first.
+func applyInstanceDesiredState(
+	ctx context.Context, client linodego.Client, instanceID, bootConfig int, d *schema.ResourceData,
+) error {
+	desired := linodego.InstanceStatus(d.Get("desired_state").(string))
+	if desired == "" {
+		return nil
 	}
-	return updateInstanceDisks(ctx, *client, d, *instance)
-}
 
-// assertDiskConfigFitsInstanceType asserts that the cumulative disk space used by a given disk config fits a given
-// linode type spec for disk capacity.
-func assertDiskConfigFitsInstanceType(d *schema.ResourceData, typ *linodego.LinodeType) error {
-	oldDisks, newDisks := d.GetChange("disk")
-	_, newDiskSize := getDiskSizeChange(oldDisks, newDisks)
-	if typ.Disk < newDiskSize {
-		return fmt.Errorf(
-			"Linode type %s has insufficient disk capacity for the config. Have %d; want %d",
-			typ.Label, typ.Disk, newDiskSize)
+	instance, err := client.GetInstance(ctx, instanceID)
+	if err != nil {
+		return fmt.Errorf("Error getting Instance %d: %s", instanceID, err)
+	}
+
+	switch instance.Status {
+	case linodego.InstanceBooting, linodego.InstanceRebooting:
+		instance, err = client.WaitForInstanceStatus(
+			ctx, instanceID, linodego.InstanceRunning, getDeadlineSeconds(ctx, d))
+	case linodego.InstanceShuttingDown:
+		instance, err = client.WaitForInstanceStatus(
+			ctx, instanceID, linodego.InstanceOffline, getDeadlineSeconds(ctx, d))
+	}
+	if err != nil {
+		return fmt.Errorf("Error waiting for Instance %d to finish its power transition: %s", instanceID, err)
+	}
+
+	if instance.Status == desired {
+		return nil
+	}
+
+	switch desired {
+	case linodego.InstanceRunning:
+		if err := client.BootInstance(ctx, instanceID, bootConfig); err != nil {
+			return fmt.Errorf("Error booting Instance %d: %s", instanceID, err)
+		}
+	case linodego.InstanceOffline:
+		if err := client.ShutdownInstance(ctx, instanceID); err != nil {
+			return fmt.Errorf("Error shutting down Instance %d: %s", instanceID, err)
+		}
+	}
+
+	if _, err := client.WaitForInstanceStatus(ctx, instanceID, desired, getDeadlineSeconds(ctx, d)); err != nil {
+		return fmt.Errorf("Error waiting for Instance %d to reach status %s: %s", instanceID, desired, err)
 	}
 	return nil
 }
 
-// applyInstanceTypeChange checks to see if the staged disk changes can be supported by the new instance
-// specification. If there is sufficient space, it attempts to update the instance type.
-func applyInstanceTypeChange(
-	ctx context.Context,
-	d *schema.ResourceData,
-	client *linodego.Client,
-	instance *linodego.Instance,
-	typ *linodego.LinodeType,
-) (*linodego.Instance, error) {
-	if err := assertDiskConfigFitsInstanceType(d, typ); err != nil {
-		return nil, err
+// diffInstanceDesiredState plans an update of an existing instance whose last known status differs from its
+// desired_state, so that the instance is booted or shut down on apply.
+func diffInstanceDesiredState(d *schema.ResourceDiff) error {
+	if d.Id() == "" || !d.NewValueKnown("desired_state") {
+		return nil
 	}
-	return changeInstanceType(ctx, client, instance.ID, typ.ID, d)
-}
 
-// detachConfigVolumes detaches any volumes associated with an InstanceConfig.Devices struct.
-func detachConfigVolumes(
-	ctx context.Context, dmap linodego.InstanceConfigDeviceMap, detacher volumeDetacher) error {
-	// Preallocate our slice of config devices
-	drives := []*linodego.InstanceConfigDevice{
-		dmap.SDA, dmap.SDB, dmap.SDC, dmap.SDD, dmap.SDE, dmap.SDF, dmap.SDG, dmap.SDH,
+	desired := d.Get("desired_state").(string)
+	if desired == "" || d.Get("status").(string) == desired {
+		return nil
 	}
+	return d.SetNewComputed("status")
+}
 
-	// Make a buffered error channel for our goroutines to send error values back on
-	errCh := make(chan error, len(drives))
+// rotateInstanceRootPass resets the password of the 'root' user account on the implicit boot disk of an instance.
+// The instance is shut down for the reset and booted again afterwards if it was running.
+func rotateInstanceRootPass(
+	ctx context.Context, client *linodego.Client, instanceID int, password string, d *schema.ResourceData,
+) error {
+	if password == "" {
+		return fmt.Errorf("Error resetting the root password of Instance %d: root_pass must be set", instanceID)
+	}
 
-	// Make a sync.WaitGroup so our devices can signal they're finished
-	var wg sync.WaitGroup
-	wg.Add(len(drives))
+	instance, err := client.GetInstance(ctx, instanceID)
+	if err != nil {
+		return fmt.Errorf("Error getting Instance %d: %s", instanceID, err)
+	}
 
-	// For each drive, spawn a goroutine to detach the volume, send an error on the err channel
-	// if one exists, and signal the worker process is done
-	for _, d := range drives {
-		go func(dev *linodego.InstanceConfigDevice) {
-			defer wg.Done()
+	bootDisk, _, err := getInstanceDefaultDisks(ctx, instanceID, client)
+	if err != nil {
+		return err
+	}
+	if bootDisk == nil {
+		return fmt.Errorf("Error resetting the root password of Instance %d: no boot disk found", instanceID)
+	}
 
-			if dev != nil && dev.VolumeID > 0 {
-				err := detacher(ctx, dev.VolumeID, "for config attachment")
-				if err != nil {
-					errCh <- err
-				}
-			}
-		}(d)
+	wasRunning := instance.Status == linodego.InstanceRunning || instance.Status == linodego.InstanceBooting
+	if instance.Status != linodego.InstanceOffline && instance.Status != linodego.InstanceShuttingDown {
+		if err := client.ShutdownInstance(ctx, instanceID); err != nil {
+			return fmt.Errorf("Error shutting down Instance %d: %s", instanceID, err)
+		}
 	}
 
-	// Wait until all processes are finished and close the error channel so we can range over it
-	wg.Wait()
-	close(errCh)
+	if _, err := client.WaitForInstanceStatus(
+		ctx, instanceID, linodego.InstanceOffline, getDeadlineSeconds(ctx, d),
+	); err != nil {
+		return fmt.Errorf("Error waiting for Instance %d to go offline: %s", instanceID, err)
+	}
 
-	// Build the error from the errors in the channel and return the combined error if any exist
-	var errStr string
-	for err := range errCh {
-		if len(errStr) == 0 {
-			errStr += ", "
-		}
+	if err := client.PasswordResetInstanceDisk(ctx, instanceID, bootDisk.ID, password); err != nil {
+		return fmt.Errorf("Error resetting the root password of Instance %d Disk %d: %s",
+			instanceID, bootDisk.ID, formatLinodeError(err))
+	}
 
-		errStr += err.Error()
+	if _, err := client.WaitForEventFinished(ctx, instanceID, linodego.EntityLinode, linodego.ActionPasswordReset,
+		*bootDisk.Updated, getDeadlineSeconds(ctx, d)); err != nil {
+		return fmt.Errorf("Error waiting for the root password of Instance %d to be reset: %s", instanceID, err)
 	}
 
-	if len(errStr) > 0 {
-		return fmt.Errorf("Error detaching volumes: %s", errStr)
+	if !wasRunning {
+		return nil
 	}
 
+	if err := client.BootInstance(ctx, instanceID, 0); err != nil {
+		return fmt.Errorf("Error booting Instance %d: %s", instanceID, err)
+	}
+	if _, err := client.WaitForInstanceStatus(
+		ctx, instanceID, linodego.InstanceRunning, getDeadlineSeconds(ctx, d),
+	); err != nil {
+		return fmt.Errorf("Error waiting for Instance %d to boot: %s", instanceID, err)
+	}
 	return nil
 }
 
-func expandLinodeConfigInterface(i map[string]interface{}) linodego.InstanceConfigInterface {
-	result := linodego.InstanceConfigInterface{}
+// diffInstanceRootPass forces a new instance when root_pass changes without a change to rotate_root_pass, which
+// guards the in-place reset of the root password against accidental changes.
+func diffInstanceRootPass(d *schema.ResourceDiff) error {
+	if d.Id() == "" || !d.HasChange("root_pass") || d.HasChange("rotate_root_pass") || isInstanceImageRebuild(d) {
+		return nil
+	}
+	return d.ForceNew("root_pass")
+}
+
+// diffInstanceImage forces a new instance when the image changes, unless allow_image_rebuild confirms that the
+// boot disk may be rebuilt from the new image in place.
+func diffInstanceImage(d *schema.ResourceDiff) error {
+	if d.Id() == "" || !d.HasChange("image") || isInstanceImageRebuild(d) {
+		return nil
+	}
+	return d.ForceNew("image")
+}
+
+// isInstanceImageRebuild reports whether the planned image change of an existing instance rebuilds its boot disk.
+func isInstanceImageRebuild(d *schema.ResourceDiff) bool {
+	if d.Id() == "" || !d.HasChange("image") || !d.Get("allow_image_rebuild").(bool) {
+		return false
+	}
+	oldImage, newImage := d.GetChange("image")
+	return oldImage.(string) != "" && newImage.(string) != ""
+}
+
+// rebuildInstanceBootDisk replaces the implicit boot disk of an instance with a disk of the same label and size
+// deployed from the new image, and points the configs booting from the old disk to the new one. The old disk is
+// deleted first, as the implicit disks fill the disk capacity of the instance type. The instance is shut down for
+// the rebuild and booted again afterwards if it was running.
+func rebuildInstanceBootDisk(
+	ctx context.Context, client *linodego.Client, instanceID int, d *schema.ResourceData,
+) error {
+	instance, err := client.GetInstance(ctx, instanceID)
+	if err != nil {
+		return fmt.Errorf("Error getting Instance %d: %s", instanceID, err)
+	}
+
+	bootDisk, _, err := getInstanceDefaultDisks(ctx, instanceID, client)
+	if err != nil {
+		return err
+	}
+	if bootDisk == nil {
+		return fmt.Errorf("Error rebuilding the boot disk of Instance %d: no boot disk found", instanceID)
+	}
+
+	configs, err := client.ListInstanceConfigs(ctx, instanceID, nil)
+	if err != nil {
+		return fmt.Errorf("Error getting the configs of Instance %d: %s", instanceID, err)
+	}
+
+	wasRunning := instance.Status == linodego.InstanceRunning || instance.Status == linodego.InstanceBooting
+	if instance.Status != linodego.InstanceOffline && instance.Status != linodego.InstanceShuttingDown {
+		if err := client.ShutdownInstance(ctx, instanceID); err != nil {
+			return fmt.Errorf("Error shutting down Instance %d: %s", instanceID, err)
+		}
+	}
+
+	if _, err := client.WaitForInstanceStatus(
+		ctx, instanceID, linodego.InstanceOffline, getDeadlineSeconds(ctx, d),
+	); err != nil {
+		return fmt.Errorf("Error waiting for Instance %d to go offline: %s", instanceID, err)
+	}
+
+	if err := client.DeleteInstanceDisk(ctx, instanceID, bootDisk.ID); err != nil {
+		return fmt.Errorf("Error deleting Instance %d Disk %d: %s", instanceID, bootDisk.ID, formatLinodeError(err))
+	}
+	if _, err := client.WaitForEventFinished(ctx, instanceID, linodego.EntityLinode, linodego.ActionDiskDelete,
+		*bootDisk.Updated, getDeadlineSeconds(ctx, d)); err != nil {
+		return fmt.Errorf("Error waiting for Instance %d Disk %d to be deleted: %s", instanceID, bootDisk.ID, err)
+	}
+
+	// root_pass is only known in plain text when it changes along with the image
+	rootPass := ""
+	if d.HasChange("root_pass") {
+		rootPass = d.Get("root_pass").(string)
+	} else {
+		log.Printf("[WARN] the rebuilt boot disk of Instance %d is given a random root password", instanceID)
+	}
+
+	diskIDLabelMap, err := createInstanceDisks(ctx, *client, *instance, []interface{}{map[string]interface{}{
+		"label":            bootDisk.Label,
+		"filesystem":       string(bootDisk.Filesystem),
+		"size":             bootDisk.Size,
+		"image":            d.Get("image").(string),
+		"root_pass":        rootPass,
+		"authorized_keys":  d.Get("authorized_keys").([]interface{}),
+		"authorized_users": d.Get("authorized_users").([]interface{}),
+		"stackscript_id":   d.Get("stackscript_id").(int),
+		"stackscript_data": d.Get("stackscript_data").(map[string]interface{}),
+	}}, d)
+	if err != nil {
+		return err
+	}
+	newDiskID := diskIDLabelMap[bootDisk.Label]
+
+	for _, config := range configs {
+		updateOpts := config.GetUpdateOptions()
+		if updateOpts.Devices == nil || !replaceInstanceConfigDisk(updateOpts.Devices, bootDisk.ID, newDiskID) {
+			continue
+		}
+		if _, err := client.UpdateInstanceConfig(ctx, instanceID, config.ID, updateOpts); err != nil {
+			return fmt.Errorf("Error updating Instance %d Config %d to boot from Disk %d: %s",
+				instanceID, config.ID, newDiskID, formatLinodeError(err))
+		}
+	}
+
+	if !wasRunning {
+		return nil
+	}
+
+	if err := client.BootInstance(ctx, instanceID, 0); err != nil {
+		return fmt.Errorf("Error booting Instance %d: %s", instanceID, err)
+	}
+	if _, err := client.WaitForInstanceStatus(
+		ctx, instanceID, linodego.InstanceRunning, getDeadlineSeconds(ctx, d),
+	); err != nil {
+		return fmt.Errorf("Error waiting for Instance %d to boot: %s", instanceID, err)
+	}
+	return nil
+}
+
+// replaceInstanceConfigDisk points every device of a config attached to the old disk to the new disk, and reports
+// whether any device was changed.
+func replaceInstanceConfigDisk(devices *linodego.InstanceConfigDeviceMap, oldDiskID, newDiskID int) bool {
+	replaced := false
+	for _, device := range []*linodego.InstanceConfigDevice{
+		devices.SDA, devices.SDB, devices.SDC, devices.SDD, devices.SDE, devices.SDF, devices.SDG, devices.SDH,
+	} {
+		if device != nil && device.DiskID == oldDiskID {
+			device.DiskID = newDiskID
+			replaced = true
+		}
+	}
+	return replaced
+}
+
+// instanceMaintenanceNotificationTypes are the account Notification types reporting maintenance of a Linode's host.
+var instanceMaintenanceNotificationTypes = map[linodego.NotificationType]bool{
+	linodego.NotificationMaintenance:        true,
+	linodego.NotificationRebootScheduled:    true,
+	linodego.NotificationMigrationScheduled: true,
+	linodego.NotificationMigrationPending:   true,
+	linodego.NotificationMigrationImminent:  true,
+}
+
+// listInstanceMaintenance returns the pending maintenance of every Linode on the account, keyed by Linode ID, from
+// the account Notifications.
+func listInstanceMaintenance(
+	ctx context.Context, client linodego.Client,
+) (map[int][]map[string]interface{}, error) {
+	notifications, err := client.ListNotifications(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing account notifications: %s", err)
+	}
+
+	maintenance := make(map[int][]map[string]interface{})
+	for _, notification := range notifications {
+		if notification.Entity == nil || notification.Entity.Type != string(linodego.EntityLinode) ||
+			!instanceMaintenanceNotificationTypes[notification.Type] {
+			continue
+		}
+
+		result := map[string]interface{}{
+			"type":     string(notification.Type),
+			"severity": string(notification.Severity),
+			"message":  notification.Message,
+		}
+		if notification.When != nil {
+			result["when"] = notification.When.Format(time.RFC3339)
+		}
+		if notification.Until != nil {
+			result["until"] = notification.Until.Format(time.RFC3339)
+		}
+		maintenance[notification.Entity.ID] = append(maintenance[notification.Entity.ID], result)
+	}
+	return maintenance, nil
+}
+
+// getInstanceTypeChange checks to see if the linode itself was resized.
+func getInstanceTypeChange(
+	ctx context.Context,
+	d *schema.ResourceData,
+	client *linodego.Client,
+) (oldSpec, newSpec *linodego.LinodeType, err error) {
+	old, new := d.GetChange("type")
+	oldType, newType := old.(string), new.(string)
+
+	oldSpec, err = client.GetType(ctx, oldType)
+	if err != nil {
+		return
+	}
+	newSpec, err = client.GetType(ctx, newType)
+	if err != nil {
+		return
+	}
+	return
+}
+
+// applyInstanceDiskSpec checks to see if the staged disk changes can be supported by the instance specification's
+// capacity. If there is sufficient space, it attempts to update the disks.
+//
+// returns bool describing whether change has occurred.
+func applyInstanceDiskSpec(
+	ctx context.Context,
+	d *schema.ResourceData,
+	client *linodego.Client,
+	instance *linodego.Instance,
+	typ *linodego.LinodeType,
+) (bool, error) {
+	if err := assertDiskConfigFitsInstanceType(d, typ); err != nil {
+		return false, err
+	}
+	return updateInstanceDisks(ctx, *client, d, *instance)
+}
+
+// assertDiskConfigFitsInstanceType asserts that the cumulative disk space used by a given disk config fits a given
+// linode type spec for disk capacity.
+func assertDiskConfigFitsInstanceType(d *schema.ResourceData, typ *linodego.LinodeType) error {
+	oldDisks, newDisks := d.GetChange("disk")
+	_, newDiskSize := getDiskSizeChange(oldDisks, newDisks)
+	if typ.Disk < newDiskSize {
+		return fmt.Errorf(
+			"Linode type %s has insufficient disk capacity for the config. Have %d; want %d",
+			typ.Label, typ.Disk, newDiskSize)
+	}
+	return nil
+}
+
+// applyInstanceTypeChange checks to see if the staged disk changes can be supported by the new instance
+// specification. If there is sufficient space, it attempts to update the instance type.
+func applyInstanceTypeChange(
+	ctx context.Context,
+	d *schema.ResourceData,
+	client *linodego.Client,
+	instance *linodego.Instance,
+	typ *linodego.LinodeType,
+) (*linodego.Instance, error) {
+	if err := assertDiskConfigFitsInstanceType(d, typ); err != nil {
+		return nil, err
+	}
+	return changeInstanceType(ctx, client, instance.ID, typ.ID, d)
+}
+
+// removeInstancePrivateIPs deletes every private IPv4 address of an instance, disabling private networking.
+func removeInstancePrivateIPs(ctx context.Context, client linodego.Client, linodeID int) error {
+	ips, err := client.GetInstanceIPAddresses(ctx, linodeID)
+	if err != nil {
+		return fmt.Errorf("Error getting IP addresses for Instance %d: %s", linodeID, err)
+	}
+
+	for _, ip := range ips.IPv4.Private {
+		if err := client.DeleteInstanceIPAddress(ctx, linodeID, ip.Address); err != nil && !isLinodeNotFound(err) {
+			return fmt.Errorf("Error removing private IP address %s from Instance %d: %s",
+				ip.Address, linodeID, formatLinodeError(err))
+		}
+	}
+	return nil
+}
+
+// getBiggestDisk returns the largest of the given disks, or nil if there are none.
+func getBiggestDisk(disks []linodego.InstanceDisk) *linodego.InstanceDisk {
+	var biggest *linodego.InstanceDisk
+	for i := range disks {
+		if biggest == nil || disks[i].Size > biggest.Size {
+			biggest = &disks[i]
+		}
+	}
+	return biggest
+}
+
+// expandInstanceBiggestDisk grows the largest disk of an instance to fill any disk space left unallocated by the
+// instance type. This is expected to run after the instance type has been upsized.
+func expandInstanceBiggestDisk(
+	ctx context.Context,
+	d *schema.ResourceData,
+	client *linodego.Client,
+	instance *linodego.Instance,
+	typ *linodego.LinodeType,
+) error {
+	disks, err := client.ListInstanceDisks(ctx, instance.ID, nil)
+	if err != nil {
+		return fmt.Errorf("Error getting disks for Instance %d: %s", instance.ID, err)
+	}
+
+	biggest := getBiggestDisk(disks)
+	if biggest == nil {
+		return nil
+	}
+
+	used := 0
+	for _, disk := range disks {
+		used += disk.Size
+	}
+
+	if typ.Disk <= used {
+		return nil
+	}
+	return changeInstanceDiskSize(ctx, client, *instance, *biggest, biggest.Size+typ.Disk-used, d)
+}
+
+// detachConfigVolumes detaches any volumes associated with an InstanceConfig.Devices struct.
+func detachConfigVolumes(
+	ctx context.Context, dmap linodego.InstanceConfigDeviceMap, detacher volumeDetacher) error {
+	// Preallocate our slice of config devices
+	drives := []*linodego.InstanceConfigDevice{
+		dmap.SDA, dmap.SDB, dmap.SDC, dmap.SDD, dmap.SDE, dmap.SDF, dmap.SDG, dmap.SDH,
+	}
+
+	// Make a buffered error channel for our goroutines to send error values back on
+	errCh := make(chan error, len(drives))
+
+	// Make a sync.WaitGroup so our devices can signal they're finished
+	var wg sync.WaitGroup
+	wg.Add(len(drives))
+
+	// For each drive, spawn a goroutine to detach the volume, send an error on the err channel
+	// if one exists, and signal the worker process is done
+	for _, d := range drives {
+		go func(dev *linodego.InstanceConfigDevice) {
+			defer wg.Done()
+
+			if dev != nil && dev.VolumeID > 0 {
+				err := detacher(ctx, dev.VolumeID, "for config attachment")
+				if err != nil {
+					errCh <- err
+				}
+			}
+		}(d)
+	}
+
+	// Wait until all processes are finished and close the error channel so we can range over it
+	wg.Wait()
+	close(errCh)
+
+	// Build the error from the errors in the channel and return the combined error if any exist
+	var errStr string
+	for err := range errCh {
+		if len(errStr) == 0 {
+			errStr += ", "
+		}
+
+		errStr += err.Error()
+	}
+
+	if len(errStr) > 0 {
+		return fmt.Errorf("Error detaching volumes: %s", errStr)
+	}
+
+	return nil
+}
+
+func expandLinodeConfigInterface(i map[string]interface{}) linodego.InstanceConfigInterface {
+	result := linodego.InstanceConfigInterface{}
 
 	result.Label = i["label"].(string)
 	result.Purpose = linodego.ConfigInterfacePurpose(i["purpose"].(string))
@@ -1050,12 +1635,626 @@ func expandLinodeConfigInterface(i map[string]interface{}) linodego.InstanceConf
 	return result
 }
 
+// instanceConfigInterfacesNeedReboot returns whether changing a config's interfaces from
+// current to desired requires a reboot. Adding, removing, or reordering interfaces, or
+// changing their purpose or label, only takes effect once the Linode is rebooted into the
+// config. An IPAM address change on an otherwise unchanged interface is saved to the
+// config without a reboot and applies the next time the Linode boots.
+func instanceConfigInterfacesNeedReboot(current, desired []linodego.InstanceConfigInterface) bool {
+	if len(current) != len(desired) {
+		return true
+	}
+
+	for i := range current {
+		if current[i].Purpose != desired[i].Purpose || current[i].Label != desired[i].Label {
+			return true
+		}
+	}
+
+	return false
+}
+
+// expandInstanceConfigSpecInterfaces returns the interfaces of the config with the given
+// label in a list of config blocks, and whether such a config was found.
+func expandInstanceConfigSpecInterfaces(tfConfigs []interface{}, label string) ([]linodego.InstanceConfigInterface, bool) {
+	for _, tfConfig := range tfConfigs {
+		tfc, ok := tfConfig.(map[string]interface{})
+		if !ok || tfc["label"].(string) != label {
+			continue
+		}
+
+		interfaces, _ := tfc["interface"].([]interface{})
+		result := make([]linodego.InstanceConfigInterface, len(interfaces))
+		for i, ni := range interfaces {
+			result[i] = expandLinodeConfigInterface(ni.(map[string]interface{}))
+		}
+		return result, true
+	}
+	return nil, false
+}
+
 func flattenLinodeConfigInterface(i linodego.InstanceConfigInterface) map[string]interface{} {
 	result := make(map[string]interface{})
 
 	result["label"] = i.Label
-	result["purpose"] = i.Purpose
+	result["purpose"] = string(i.Purpose)
 	result["ipam_address"] = i.IPAMAddress
 
 	return result
 }
+
+// validateInstanceReadOnlyRootDevice ensures that no config boots from a disk
+// marked as read_only.
+func validateInstanceReadOnlyRootDevice(d *schema.ResourceDiff) error {
+	readOnlyDisks := make(map[string]struct{})
+	for _, disk := range d.Get("disk").([]interface{}) {
+		disk := disk.(map[string]interface{})
+		if disk["read_only"].(bool) {
+			readOnlyDisks[disk["label"].(string)] = struct{}{}
+		}
+	}
+
+	if len(readOnlyDisks) == 0 {
+		return nil
+	}
+
+	for _, config := range d.Get("config").([]interface{}) {
+		config := config.(map[string]interface{})
+		rootDevice := strings.TrimPrefix(config["root_device"].(string), "/dev/")
+
+		devices := config["devices"].([]interface{})
+		if rootDevice == "" || len(devices) == 0 || devices[0] == nil {
+			continue
+		}
+
+		slot, ok := devices[0].(map[string]interface{})[rootDevice].([]interface{})
+		if !ok || len(slot) == 0 || slot[0] == nil {
+			continue
+		}
+
+		diskLabel := slot[0].(map[string]interface{})["disk_label"].(string)
+		if _, ok := readOnlyDisks[diskLabel]; ok {
+			return fmt.Errorf("config %q cannot use read_only disk %q as its root device",
+				config["label"], diskLabel)
+		}
+	}
+	return nil
+}
+
+// validateInstanceConfigRootDevices ensures that the root_device of each config
+// refers to a device slot that is defined in the config's devices block.
+func validateInstanceConfigRootDevices(d *schema.ResourceDiff) error {
+	for _, config := range d.Get("config").([]interface{}) {
+		config := config.(map[string]interface{})
+
+		rootDevice := config["root_device"].(string)
+		if !instanceConfigDeviceSlotRegex.MatchString(rootDevice) {
+			continue
+		}
+
+		devices := config["devices"].([]interface{})
+		if len(devices) == 0 || devices[0] == nil {
+			continue
+		}
+
+		slot := strings.TrimPrefix(rootDevice, "/dev/")
+		if slotDevice, ok := devices[0].(map[string]interface{})[slot].([]interface{}); !ok || len(slotDevice) == 0 {
+			return fmt.Errorf("config %q has root_device %q but no device is defined in slot %q",
+				config["label"], rootDevice, slot)
+		}
+	}
+	return nil
+}
+
+// warnInstanceWithoutDisks logs a warning when a new instance declares no image, backup, disks, or configs.
+// The API accepts such an instance, but it has nothing to boot from.
+func warnInstanceWithoutDisks(d *schema.ResourceDiff) {
+	if d.Id() != "" {
+		return
+	}
+
+	// an image or backup that is not known yet, e.g. one created in the same plan, will be set
+	for _, key := range []string{"image", "backup_id"} {
+		if _, ok := d.GetOk(key); ok || !d.NewValueKnown(key) {
+			return
+		}
+	}
+	for _, key := range []string{"restore_from_backup", "disk", "config"} {
+		if _, ok := d.GetOk(key); ok {
+			return
+		}
+	}
+
+	log.Printf("[WARN] Linode instance %q declares no image, backup_id, restore_from_backup, disk, or config "+
+		"and will be created without anything to boot from", d.Get("label"))
+}
+
+// validateInstanceBootConfigLabel ensures that boot_config_label references one of the declared configs.
+func validateInstanceBootConfigLabel(d *schema.ResourceDiff) error {
+	if !d.NewValueKnown("boot_config_label") || !d.NewValueKnown("config") {
+		return nil
+	}
+
+	bootConfigLabel := d.Get("boot_config_label").(string)
+	configs := d.Get("config").([]interface{})
+	if bootConfigLabel == "" || len(configs) == 0 {
+		return nil
+	}
+
+	labels := make([]string, 0, len(configs))
+	for _, config := range configs {
+		label := config.(map[string]interface{})["label"].(string)
+		if label == bootConfigLabel {
+			return nil
+		}
+		labels = append(labels, fmt.Sprintf("%q", label))
+	}
+	return fmt.Errorf("boot_config_label %q does not match any declared config; expected one of %s",
+		bootConfigLabel, strings.Join(labels, ", "))
+}
+
+// validateInstanceConfigMemoryLimits ensures that no config requests a memory_limit
+// larger than the memory of the instance's type.
+func validateInstanceConfigMemoryLimits(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("type") || !d.NewValueKnown("config") {
+		return nil
+	}
+
+	maxLimit := 0
+	for _, config := range d.Get("config").([]interface{}) {
+		if limit := config.(map[string]interface{})["memory_limit"].(int); limit > maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	typeID := d.Get("type").(string)
+	if maxLimit == 0 || typeID == "" {
+		return nil
+	}
+
+	client := meta.(*ProviderMeta).Client
+	linodeType, err := client.GetType(ctx, typeID)
+	if err != nil {
+		// an unknown type is reported by validateInstanceTypeChange
+		log.Printf("[WARN] failed to get type %q to validate config memory_limit: %s", typeID, err)
+		return nil
+	}
+
+	for _, config := range d.Get("config").([]interface{}) {
+		config := config.(map[string]interface{})
+		if limit := config["memory_limit"].(int); limit > linodeType.Memory {
+			return fmt.Errorf("config %q has memory_limit %d which exceeds the %d MB of memory of type %q",
+				config["label"], limit, linodeType.Memory, typeID)
+		}
+	}
+	return nil
+}
+
+// validateInstanceImages ensures that the Images deployed to an instance or its disks are available and, when a
+// StackScript is given, that the StackScript is compatible with the Image. A StackScript declaring
+// linodeStackscriptAnyImage is compatible with every available Image.
+func validateInstanceImages(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	type deployment struct {
+		image         string
+		stackscriptID int
+	}
+
+	var deployments []deployment
+	if d.HasChange("image") && d.NewValueKnown("image") && d.NewValueKnown("stackscript_id") {
+		deployments = append(deployments, deployment{d.Get("image").(string), d.Get("stackscript_id").(int)})
+	}
+	if d.HasChange("disk") && d.NewValueKnown("disk") {
+		for _, disk := range d.Get("disk").([]interface{}) {
+			disk := disk.(map[string]interface{})
+			deployments = append(deployments, deployment{disk["image"].(string), disk["stackscript_id"].(int)})
+		}
+	}
+
+	client := meta.(*ProviderMeta).Client
+	checkedImages := make(map[string]bool)
+	for _, deploy := range deployments {
+		if deploy.image == "" {
+			continue
+		}
+
+		if !checkedImages[deploy.image] {
+			image, err := client.GetImage(ctx, deploy.image)
+			if err != nil {
+				if isLinodeNotFound(err) {
+					return fmt.Errorf("image %q is not an available Image", deploy.image)
+				}
+				return fmt.Errorf("Error getting Image %q: %s", deploy.image, err)
+			}
+			if image.Deprecated {
+				log.Printf("[WARN] image %q is deprecated and will no longer be deployable once it is retired",
+					deploy.image)
+			}
+			checkedImages[deploy.image] = true
+		}
+
+		if deploy.stackscriptID == 0 {
+			continue
+		}
+
+		stackscript, err := client.GetStackscript(ctx, deploy.stackscriptID)
+		if err != nil {
+			return fmt.Errorf("Error getting StackScript %d: %s", deploy.stackscriptID, err)
+		}
+		if !isStackscriptImageCompatible(stackscript.Images, deploy.image) {
+			return fmt.Errorf("image %q is not compatible with StackScript %d, which supports %v",
+				deploy.image, deploy.stackscriptID, stackscript.Images)
+		}
+	}
+	return nil
+}
+
+// isStackscriptImageCompatible reports whether an Image may be deployed with a StackScript declaring images.
+func isStackscriptImageCompatible(images []string, image string) bool {
+	for _, candidate := range images {
+		if candidate == image || candidate == linodeStackscriptAnyImage {
+			return true
+		}
+	}
+	return false
+}
+
+// validateInstanceConfigKernels ensures that the kernel of every config exists. Aliases such as
+// linode/latest-64bit are kernels of their own and are kept as is, so they don't drift when the kernel
+// they point to is updated.
+func validateInstanceConfigKernels(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("config") || !d.NewValueKnown("config") {
+		return nil
+	}
+
+	client := meta.(*ProviderMeta).Client
+	checked := make(map[string]bool)
+	for _, config := range d.Get("config").([]interface{}) {
+		config := config.(map[string]interface{})
+		kernelID := config["kernel"].(string)
+		if kernelID == "" || checked[kernelID] {
+			continue
+		}
+
+		kernel, err := client.GetKernel(ctx, kernelID)
+		if err != nil {
+			return fmt.Errorf("Error getting kernel %q of config %q: %s", kernelID, config["label"], err)
+		}
+		// GetKernel does not report a missing kernel as an error, so check the result instead
+		if kernel == nil || kernel.ID != kernelID {
+			return fmt.Errorf("config %q has kernel %q which is not an available kernel", config["label"], kernelID)
+		}
+		if kernel.Deprecated {
+			log.Printf("[WARN] kernel %q of config %q is deprecated", kernelID, config["label"])
+		}
+		checked[kernelID] = true
+	}
+	return nil
+}
+
+// validateInstanceRootDiskSize ensures that the root disk and the swap disk of an instance deployed with
+// root_disk_size fit the disk capacity of its type.
+func validateInstanceRootDiskSize(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("root_disk_size") || !d.NewValueKnown("type") {
+		return nil
+	}
+
+	rootDiskSize := d.Get("root_disk_size").(int)
+	typeID := d.Get("type").(string)
+	if rootDiskSize == 0 || typeID == "" {
+		return nil
+	}
+
+	// the Linode API creates a 512 MB swap disk unless swap_size is given
+	swapSize := 512
+	if d.NewValueKnown("swap_size") {
+		if size := d.Get("swap_size").(int); size > 0 {
+			swapSize = size
+		}
+	}
+
+	client := meta.(*ProviderMeta).Client
+	linodeType, err := client.GetType(ctx, typeID)
+	if err != nil {
+		// an unknown type is reported by validateInstanceTypeChange
+		log.Printf("[WARN] failed to get type %q to validate root_disk_size: %s", typeID, err)
+		return nil
+	}
+
+	if rootDiskSize+swapSize > linodeType.Disk {
+		return fmt.Errorf("root_disk_size %d and swap disk size %d exceed the %d MB of disk of type %q",
+			rootDiskSize, swapSize, linodeType.Disk, typeID)
+	}
+	return nil
+}
+
+// validateInstanceTypeChange ensures that a changed instance type exists and
+// logs a warning when a resize moves the instance to a different plan class.
+func validateInstanceTypeChange(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("type") || !d.NewValueKnown("type") {
+		return nil
+	}
+
+	client := meta.(*ProviderMeta).Client
+	oldType, newType := d.GetChange("type")
+
+	targetType, err := client.GetType(ctx, newType.(string))
+	if err != nil {
+		if isLinodeNotFound(err) {
+			return fmt.Errorf("instance type %q does not exist", newType)
+		}
+		return fmt.Errorf("failed to get instance type %q: %s", newType, err)
+	}
+
+	if d.Id() == "" || oldType.(string) == "" {
+		return nil
+	}
+
+	currentType, err := client.GetType(ctx, oldType.(string))
+	if err != nil {
+		// the current type may have been retired; the resize itself is still valid
+		log.Printf("[WARN] failed to get current type %q of Linode Instance %s: %s", oldType, d.Id(), err)
+		return nil
+	}
+
+	if currentType.Class != targetType.Class {
+		log.Printf("[WARN] Linode Instance %s will be resized from class %q (%s) to class %q (%s); "+
+			"this changes the instance's billing and requires migrating it to a new host",
+			d.Id(), currentType.Class, currentType.ID, targetType.Class, targetType.ID)
+	}
+	return nil
+}
+
+// restoreInstanceBackup restores the Backup described by the restore_from_backup
+// block into the given instance and waits for the restore to complete.
+func restoreInstanceBackup(
+	ctx context.Context, client linodego.Client, instance linodego.Instance, d *schema.ResourceData,
+) error {
+	sourceID := d.Get("restore_from_backup.0.linode_id").(int)
+	backupID := d.Get("restore_from_backup.0.backup_id").(int)
+
+	// the target Linode was created empty, so there is nothing for overwrite to remove
+	restoreOpts := linodego.RestoreInstanceOptions{
+		LinodeID:  instance.ID,
+		Overwrite: true,
+	}
+
+	if err := client.RestoreInstanceBackup(ctx, sourceID, backupID, restoreOpts); err != nil {
+		return fmt.Errorf("Error restoring backup %d of Linode %d to Linode %d: %s",
+			backupID, sourceID, instance.ID, formatLinodeError(err))
+	}
+
+	if _, err := client.WaitForEventFinished(ctx, instance.ID, linodego.EntityLinode, linodego.ActionBackupsRestore,
+		*instance.Created, getDeadlineSeconds(ctx, d)); err != nil {
+		return fmt.Errorf("Error waiting for backup %d to be restored to Linode %d: %s", backupID, instance.ID, err)
+	}
+
+	return nil
+}
+
+// getInstanceFirewallDevice returns the device attaching the given instance to the
+// Firewall, or nil if the instance is not attached to it.
+func getInstanceFirewallDevice(
+	ctx context.Context, client linodego.Client, firewallID, linodeID int,
+) (*linodego.FirewallDevice, error) {
+	devices, err := client.ListFirewallDevices(ctx, firewallID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, device := range devices {
+		if device.Entity.Type == linodego.FirewallDeviceLinode && device.Entity.ID == linodeID {
+			return &device, nil
+		}
+	}
+	return nil, nil
+}
+
+// getInstanceFirewallIDs returns the IDs of the Firewalls the given instance is attached to.
+// linodego has no wrapper for the instance's firewalls endpoint, so it is requested directly.
+func getInstanceFirewallIDs(ctx context.Context, client linodego.Client, linodeID int) ([]int, error) {
+	firewallIDs := []int{}
+	for page, pages := 1, 1; page <= pages; page++ {
+		result := &linodego.FirewallsPagedResponse{}
+		resp, err := client.R(ctx).
+			SetResult(result).
+			SetQueryParam("page", strconv.Itoa(page)).
+			Get(fmt.Sprintf("linode/instances/%d/firewalls", linodeID))
+		if err != nil {
+			return nil, err
+		}
+		if resp.IsError() {
+			return nil, linodego.NewError(resp)
+		}
+
+		for _, firewall := range result.Data {
+			firewallIDs = append(firewallIDs, firewall.ID)
+		}
+		if result.PageOptions != nil {
+			pages = result.Pages
+		}
+	}
+	return firewallIDs, nil
+}
+
+// attachInstanceFirewall attaches the instance to the Firewall. A firewallID of 0 is ignored.
+func attachInstanceFirewall(ctx context.Context, client linodego.Client, firewallID, linodeID int) error {
+	if firewallID == 0 {
+		return nil
+	}
+
+	if _, err := client.CreateFirewallDevice(ctx, firewallID, linodego.FirewallDeviceCreateOptions{
+		ID:   linodeID,
+		Type: linodego.FirewallDeviceLinode,
+	}); err != nil {
+		return fmt.Errorf("Error attaching Linode instance %d to Firewall %d: %s",
+			linodeID, firewallID, formatLinodeError(err))
+	}
+	return nil
+}
+
+// detachInstanceFirewall detaches the instance from the Firewall if it is attached.
+// A firewallID of 0 is ignored.
+func detachInstanceFirewall(ctx context.Context, client linodego.Client, firewallID, linodeID int) error {
+	if firewallID == 0 {
+		return nil
+	}
+
+	device, err := getInstanceFirewallDevice(ctx, client, firewallID, linodeID)
+	if err != nil {
+		if isLinodeNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("Error getting the Firewall %d devices for Linode instance %d: %s", firewallID, linodeID, err)
+	}
+	if device == nil {
+		return nil
+	}
+
+	if err := client.DeleteFirewallDevice(ctx, firewallID, device.ID); err != nil && !isLinodeNotFound(err) {
+		return fmt.Errorf("Error detaching Linode instance %d from Firewall %d: %s", linodeID, firewallID, err)
+	}
+	return nil
+}
+
+// createInstanceFirewall creates the dedicated Firewall declared by the firewall block of an instance
+// with the instance already attached to it.
+func createInstanceFirewall(
+	ctx context.Context, client linodego.Client, linodeID int, spec map[string]interface{},
+) (int, error) {
+	createOpts := linodego.FirewallCreateOptions{
+		Label: spec["label"].(string),
+	}
+	createOpts.Devices.Linodes = []int{linodeID}
+	createOpts.Rules = expandInstanceFirewallRuleSet(spec)
+
+	if len(createOpts.Rules.Inbound)+len(createOpts.Rules.Outbound) == 0 {
+		return 0, fmt.Errorf("cannot create firewall for Linode instance %d without at least one inbound or "+
+			"outbound rule", linodeID)
+	}
+
+	firewall, err := client.CreateFirewall(ctx, createOpts)
+	if err != nil {
+		return 0, fmt.Errorf("Error creating Firewall for Linode instance %d: %s", linodeID, formatLinodeError(err))
+	}
+	return firewall.ID, nil
+}
+
+// updateInstanceFirewall applies changes to the firewall block of an instance, creating or deleting the
+// dedicated Firewall when the block is added or removed.
+func updateInstanceFirewall(ctx context.Context, client linodego.Client, d *schema.ResourceData, linodeID int) error {
+	oldFirewalls, newFirewalls := d.GetChange("firewall")
+
+	firewallID := 0
+	if old := oldFirewalls.([]interface{}); len(old) > 0 && old[0] != nil {
+		firewallID = old[0].(map[string]interface{})["id"].(int)
+	}
+
+	newList := newFirewalls.([]interface{})
+	if len(newList) == 0 || newList[0] == nil {
+		if firewallID == 0 {
+			return nil
+		}
+		if err := client.DeleteFirewall(ctx, firewallID); err != nil && !isLinodeNotFound(err) {
+			return fmt.Errorf("Error deleting Firewall %d of Linode instance %d: %s", firewallID, linodeID, err)
+		}
+		d.Set("firewall", nil)
+		return nil
+	}
+
+	spec := newList[0].(map[string]interface{})
+	if firewallID == 0 {
+		id, err := createInstanceFirewall(ctx, client, linodeID, spec)
+		if err != nil {
+			return err
+		}
+		spec["id"] = id
+		d.Set("firewall", []map[string]interface{}{spec})
+		return nil
+	}
+
+	if d.HasChange("firewall.0.label") {
+		if _, err := client.UpdateFirewall(ctx, firewallID, linodego.FirewallUpdateOptions{
+			Label: spec["label"].(string),
+		}); err != nil {
+			return fmt.Errorf("Error updating Firewall %d of Linode instance %d: %s",
+				firewallID, linodeID, formatLinodeError(err))
+		}
+	}
+
+	if _, err := client.UpdateFirewallRules(ctx, firewallID, expandInstanceFirewallRuleSet(spec)); err != nil {
+		return fmt.Errorf("Error updating rules for Firewall %d of Linode instance %d: %s",
+			firewallID, linodeID, formatLinodeError(err))
+	}
+	return nil
+}
+
+// expandInstanceFirewallRuleSet converts the rules of a firewall block into the rule set accepted by the API.
+func expandInstanceFirewallRuleSet(spec map[string]interface{}) linodego.FirewallRuleSet {
+	return linodego.FirewallRuleSet{
+		Inbound:        expandLinodeFirewallRules(spec["inbound"].([]interface{})),
+		InboundPolicy:  spec["inbound_policy"].(string),
+		Outbound:       expandLinodeFirewallRules(spec["outbound"].([]interface{})),
+		OutboundPolicy: spec["outbound_policy"].(string),
+	}
+}
+
+// flattenInstanceFirewall reads the dedicated Firewall of an instance into its firewall block.
+// The presets of the declared rules are carried over as they are not returned by the API.
+func flattenInstanceFirewall(
+	ctx context.Context, client linodego.Client, firewallID int, declared map[string]interface{},
+) (map[string]interface{}, error) {
+	firewall, err := client.GetFirewall(ctx, firewallID)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := client.GetFirewallRules(ctx, firewallID)
+	if err != nil {
+		return nil, err
+	}
+
+	declaredInbound, _ := declared["inbound"].([]interface{})
+	declaredOutbound, _ := declared["outbound"].([]interface{})
+
+	return map[string]interface{}{
+		"id":    firewall.ID,
+		"label": firewall.Label,
+		"inbound": applyLinodeFirewallRulePresets(
+			flattenLinodeFirewallRules(rules.Inbound), declaredInbound),
+		"inbound_policy": rules.InboundPolicy,
+		"outbound": applyLinodeFirewallRulePresets(
+			flattenLinodeFirewallRules(rules.Outbound), declaredOutbound),
+		"outbound_policy": rules.OutboundPolicy,
+	}, nil
+}
+
+// expandInstanceConfigHelpers converts a config's helpers block into the options accepted by the API.
+// A nil value is returned when the block is absent so that the API defaults are used.
+func expandInstanceConfigHelpers(helpers []interface{}) *linodego.InstanceConfigHelpers {
+	if len(helpers) == 0 {
+		return nil
+	}
+
+	helpersMap, ok := helpers[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	result := &linodego.InstanceConfigHelpers{}
+	if updateDBDisabled, ok := helpersMap["updatedb_disabled"].(bool); ok {
+		result.UpdateDBDisabled = updateDBDisabled
+	}
+	if distro, ok := helpersMap["distro"].(bool); ok {
+		result.Distro = distro
+	}
+	if modulesDep, ok := helpersMap["modules_dep"].(bool); ok {
+		result.ModulesDep = modulesDep
+	}
+	if network, ok := helpersMap["network"].(bool); ok {
+		result.Network = network
+	}
+	if devTmpFsAutomount, ok := helpersMap["devtmpfs_automount"].(bool); ok {
+		result.DevTmpFsAutomount = devTmpFsAutomount
+	}
+	return result
+}
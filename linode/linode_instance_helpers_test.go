@@ -0,0 +1,344 @@
+package linode
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/linode/linodego"
+)
+
+func TestExpandInstanceConfigHelpers(t *testing.T) {
+	if helpers := expandInstanceConfigHelpers(nil); helpers != nil {
+		t.Fatalf("expected nil helpers for an empty block, got %+v", helpers)
+	}
+
+	defaults := map[string]interface{}{
+		"updatedb_disabled":  true,
+		"distro":             true,
+		"modules_dep":        true,
+		"network":            true,
+		"devtmpfs_automount": true,
+	}
+
+	toggled := map[string]func(*linodego.InstanceConfigHelpers) bool{
+		"updatedb_disabled":  func(h *linodego.InstanceConfigHelpers) bool { return h.UpdateDBDisabled },
+		"distro":             func(h *linodego.InstanceConfigHelpers) bool { return h.Distro },
+		"modules_dep":        func(h *linodego.InstanceConfigHelpers) bool { return h.ModulesDep },
+		"network":            func(h *linodego.InstanceConfigHelpers) bool { return h.Network },
+		"devtmpfs_automount": func(h *linodego.InstanceConfigHelpers) bool { return h.DevTmpFsAutomount },
+	}
+
+	for name := range toggled {
+		helpersMap := make(map[string]interface{}, len(defaults))
+		for k, v := range defaults {
+			helpersMap[k] = v
+		}
+		helpersMap[name] = false
+
+		helpers := expandInstanceConfigHelpers([]interface{}{helpersMap})
+		if helpers == nil {
+			t.Fatalf("expected helpers when toggling %s", name)
+		}
+
+		for field, get := range toggled {
+			if expected := field != name; get(helpers) != expected {
+				t.Errorf("toggling %s: expected %s to be %t", name, field, expected)
+			}
+		}
+	}
+}
+
+func TestInstanceConfigInterfacesNeedReboot(t *testing.T) {
+	public := linodego.InstanceConfigInterface{Purpose: linodego.InterfacePurposePublic}
+	vlan := linodego.InstanceConfigInterface{
+		Purpose: linodego.InterfacePurposeVLAN, Label: "vlan-a", IPAMAddress: "10.0.0.1/24"}
+
+	cases := []struct {
+		name     string
+		current  []linodego.InstanceConfigInterface
+		desired  []linodego.InstanceConfigInterface
+		expected bool
+	}{
+		{"unchanged", []linodego.InstanceConfigInterface{public, vlan}, []linodego.InstanceConfigInterface{public, vlan}, false},
+		{"both empty", nil, []linodego.InstanceConfigInterface{}, false},
+		{"added", []linodego.InstanceConfigInterface{public}, []linodego.InstanceConfigInterface{public, vlan}, true},
+		{"removed", []linodego.InstanceConfigInterface{public, vlan}, []linodego.InstanceConfigInterface{public}, true},
+		{"reordered", []linodego.InstanceConfigInterface{public, vlan}, []linodego.InstanceConfigInterface{vlan, public}, true},
+		{
+			"label changed",
+			[]linodego.InstanceConfigInterface{vlan},
+			[]linodego.InstanceConfigInterface{{Purpose: vlan.Purpose, Label: "vlan-b", IPAMAddress: vlan.IPAMAddress}},
+			true,
+		},
+		{
+			"ipam address changed",
+			[]linodego.InstanceConfigInterface{vlan},
+			[]linodego.InstanceConfigInterface{{Purpose: vlan.Purpose, Label: vlan.Label, IPAMAddress: "10.0.0.2/24"}},
+			false,
+		},
+	}
+
+	for _, c := range cases {
+		if result := instanceConfigInterfacesNeedReboot(c.current, c.desired); result != c.expected {
+			t.Errorf("%s: expected %t, got %t", c.name, c.expected, result)
+		}
+	}
+}
+
+func TestGetBiggestDisk(t *testing.T) {
+	if disk := getBiggestDisk(nil); disk != nil {
+		t.Fatalf("expected no disk, got %+v", disk)
+	}
+
+	disks := []linodego.InstanceDisk{
+		{ID: 1, Size: 256, Filesystem: linodego.FilesystemSwap},
+		{ID: 2, Size: 25344, Filesystem: linodego.FilesystemExt4},
+		{ID: 3, Size: 1024, Filesystem: linodego.FilesystemRaw},
+	}
+
+	if disk := getBiggestDisk(disks); disk == nil || disk.ID != 2 {
+		t.Fatalf("expected disk 2 to be the biggest, got %+v", disk)
+	}
+}
+
+func TestCarryOverInstanceDiskWriteOnlyFields(t *testing.T) {
+	disks := []map[string]interface{}{
+		{"label": "boot", "size": 25000},
+		{"label": "new", "size": 500},
+	}
+	stateDisks := []interface{}{
+		map[string]interface{}{
+			"label":           "boot",
+			"size":            20000,
+			"image":           "linode/alpine3.12",
+			"authorized_keys": []interface{}{"ssh-rsa AAAA"},
+			"stackscript_id":  123,
+			"read_only":       true,
+		},
+	}
+
+	carryOverInstanceDiskWriteOnlyFields(disks, stateDisks)
+
+	if disks[0]["image"] != "linode/alpine3.12" || disks[0]["stackscript_id"] != 123 || disks[0]["read_only"] != true {
+		t.Errorf("expected write-only fields to be carried over, got %+v", disks[0])
+	}
+	if disks[0]["size"] != 25000 {
+		t.Errorf("expected size to be read from the API, got %v", disks[0]["size"])
+	}
+	if _, ok := disks[1]["image"]; ok {
+		t.Errorf("expected no image for a disk that is not in state, got %+v", disks[1])
+	}
+}
+
+func TestExpandInstanceDiskCreateOptions(t *testing.T) {
+	opts, err := expandInstanceDiskCreateOptions(diskSpec{
+		"label":            "boot",
+		"filesystem":       "ext4",
+		"size":             3000,
+		"image":            "linode/alpine3.12",
+		"root_pass":        "terraform-test",
+		"authorized_keys":  []interface{}{"ssh-rsa AAAA"},
+		"stackscript_id":   123,
+		"stackscript_data": map[string]interface{}{"hostname": "test"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if opts.Label != "boot" || opts.Size != 3000 || opts.Image != "linode/alpine3.12" || opts.StackscriptID != 123 {
+		t.Errorf("unexpected disk create options: %+v", opts)
+	}
+	if len(opts.AuthorizedKeys) != 1 || opts.StackscriptData["hostname"] != "test" {
+		t.Errorf("unexpected disk provisioning options: %+v", opts)
+	}
+
+	if _, err := expandInstanceDiskCreateOptions(diskSpec{
+		"label":            "boot",
+		"filesystem":       "ext4",
+		"size":             3000,
+		"image":            "linode/alpine3.12",
+		"stackscript_data": "invalid",
+	}); err == nil {
+		t.Error("expected an error for invalid stackscript_data")
+	}
+}
+
+func TestFlattenInstanceConfigDevice(t *testing.T) {
+	diskLabelIDMap := map[int]string{1: "boot"}
+
+	for name, tc := range map[string]struct {
+		device   *linodego.InstanceConfigDevice
+		expected []map[string]interface{}
+	}{
+		"nil":   {device: nil, expected: nil},
+		"empty": {device: &linodego.InstanceConfigDevice{}, expected: nil},
+		"disk": {
+			device:   &linodego.InstanceConfigDevice{DiskID: 1},
+			expected: []map[string]interface{}{{"disk_id": 1, "disk_label": "boot"}},
+		},
+		"unlabeled disk": {
+			device:   &linodego.InstanceConfigDevice{DiskID: 2},
+			expected: []map[string]interface{}{{"disk_id": 2}},
+		},
+		"volume": {
+			device:   &linodego.InstanceConfigDevice{VolumeID: 3},
+			expected: []map[string]interface{}{{"volume_id": 3}},
+		},
+	} {
+		if result := flattenInstanceConfigDevice(tc.device, diskLabelIDMap); !reflect.DeepEqual(result, tc.expected) {
+			t.Errorf("%s: expected %v, got %v", name, tc.expected, result)
+		}
+	}
+}
+
+func TestFlattenInstanceConfigsInterfaces(t *testing.T) {
+	configs := flattenInstanceConfigs([]linodego.InstanceConfig{{
+		Label:   "boot",
+		Devices: &linodego.InstanceConfigDeviceMap{},
+		Helpers: &linodego.InstanceConfigHelpers{},
+		Interfaces: []linodego.InstanceConfigInterface{
+			{Purpose: linodego.InterfacePurposePublic},
+			{Purpose: linodego.InterfacePurposeVLAN, Label: "vlan", IPAMAddress: "10.0.0.1/24"},
+		},
+	}}, map[int]string{})
+
+	expected := []interface{}{
+		map[string]interface{}{"purpose": "public", "label": "", "ipam_address": ""},
+		map[string]interface{}{"purpose": "vlan", "label": "vlan", "ipam_address": "10.0.0.1/24"},
+	}
+	if len(configs) != 1 || !reflect.DeepEqual(configs[0]["interface"], expected) {
+		t.Fatalf("expected the interfaces %v in order, got %v", expected, configs)
+	}
+}
+
+func TestReplaceInstanceConfigDisk(t *testing.T) {
+	devices := &linodego.InstanceConfigDeviceMap{
+		SDA: &linodego.InstanceConfigDevice{DiskID: 1},
+		SDB: &linodego.InstanceConfigDevice{DiskID: 2},
+		SDC: &linodego.InstanceConfigDevice{VolumeID: 1},
+	}
+
+	if !replaceInstanceConfigDisk(devices, 1, 3) {
+		t.Fatal("expected the devices attached to disk 1 to be replaced")
+	}
+	if devices.SDA.DiskID != 3 || devices.SDB.DiskID != 2 || devices.SDC.DiskID != 0 || devices.SDC.VolumeID != 1 {
+		t.Fatalf("expected only sda to point to disk 3, got %+v %+v %+v", devices.SDA, devices.SDB, devices.SDC)
+	}
+	if replaceInstanceConfigDisk(devices, 4, 5) {
+		t.Fatal("expected no device to be attached to disk 4")
+	}
+}
+
+func TestListInstanceMaintenance(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"page": 1, "pages": 1, "results": 3, "data": [
+			{"type": "migration_scheduled", "severity": "major", "message": "migration",
+			 "when": "2021-05-01T10:00:00", "entity": {"id": 1, "type": "linode"}},
+			{"type": "payment_due", "severity": "minor", "message": "payment", "entity": null},
+			{"type": "maintenance", "severity": "minor", "message": "volume", "entity": {"id": 1, "type": "volume"}}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := linodego.NewClient(server.Client())
+	client.SetBaseURL(server.URL)
+
+	maintenance, err := listInstanceMaintenance(context.Background(), client)
+	if err != nil {
+		t.Fatalf("expected the maintenance to be listed, got %s", err)
+	}
+
+	expected := map[int][]map[string]interface{}{
+		1: {{
+			"type":     "migration_scheduled",
+			"severity": "major",
+			"message":  "migration",
+			"when":     "2021-05-01T10:00:00Z",
+		}},
+	}
+	if !reflect.DeepEqual(maintenance, expected) {
+		t.Fatalf("expected %v, got %v", expected, maintenance)
+	}
+}
+
+func TestGetInstanceFirewallIDs(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path+"?"+r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("page") == "1" {
+			w.Write([]byte(`{"page": 1, "pages": 2, "results": 2, "data": [{"id": 10}]}`))
+			return
+		}
+		w.Write([]byte(`{"page": 2, "pages": 2, "results": 2, "data": [{"id": 20}]}`))
+	}))
+	defer server.Close()
+
+	client := linodego.NewClient(server.Client())
+	client.SetBaseURL(server.URL)
+
+	firewallIDs, err := getInstanceFirewallIDs(context.Background(), client, 1)
+	if err != nil {
+		t.Fatalf("expected the firewalls to be listed, got %s", err)
+	}
+	if !reflect.DeepEqual(firewallIDs, []int{10, 20}) {
+		t.Errorf("expected firewalls [10 20], got %v", firewallIDs)
+	}
+
+	expected := []string{"/linode/instances/1/firewalls?page=1", "/linode/instances/1/firewalls?page=2"}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Errorf("expected requests %v, got %v", expected, paths)
+	}
+}
+
+func TestIsStackscriptImageCompatible(t *testing.T) {
+	for _, tc := range []struct {
+		images     []string
+		image      string
+		compatible bool
+	}{
+		{images: []string{"linode/debian10"}, image: "linode/debian10", compatible: true},
+		{images: []string{"linode/debian10"}, image: "linode/ubuntu20.04", compatible: false},
+		{images: []string{"any/all"}, image: "linode/ubuntu20.04", compatible: true},
+		{images: []string{"any/all"}, image: "private/1234", compatible: true},
+		{images: nil, image: "linode/debian10", compatible: false},
+	} {
+		if compatible := isStackscriptImageCompatible(tc.images, tc.image); compatible != tc.compatible {
+			t.Errorf("expected %s with %v to be compatible=%t", tc.image, tc.images, tc.compatible)
+		}
+	}
+}
+
+func TestGetInstanceDiskFilesystemPaths(t *testing.T) {
+	configs := []linodego.InstanceConfig{
+		{
+			Label: "rescue",
+			Devices: &linodego.InstanceConfigDeviceMap{
+				SDA: &linodego.InstanceConfigDevice{DiskID: 2},
+				SDB: &linodego.InstanceConfigDevice{DiskID: 3},
+			},
+		},
+		{
+			Label: "boot",
+			Devices: &linodego.InstanceConfigDeviceMap{
+				SDA: &linodego.InstanceConfigDevice{DiskID: 1},
+				SDB: &linodego.InstanceConfigDevice{DiskID: 2},
+				SDC: &linodego.InstanceConfigDevice{VolumeID: 4},
+			},
+		},
+	}
+
+	expected := map[int]string{1: "/dev/sda", 2: "/dev/sdb", 3: "/dev/sdb"}
+	if paths := getInstanceDiskFilesystemPaths(configs, "boot"); !reflect.DeepEqual(paths, expected) {
+		t.Errorf("expected %v, got %v", expected, paths)
+	}
+
+	expected = map[int]string{1: "/dev/sda", 2: "/dev/sda", 3: "/dev/sdb"}
+	if paths := getInstanceDiskFilesystemPaths(configs, ""); !reflect.DeepEqual(paths, expected) {
+		t.Errorf("expected %v, got %v", expected, paths)
+	}
+}
@@ -15,10 +15,22 @@ func Provider() *schema.Provider {
 		Schema: map[string]*schema.Schema{
 			"token": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("LINODE_TOKEN", nil),
 				Description: "The token that allows you access to your Linode account",
 			},
+			"config_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("LINODE_CONFIG", DefaultConfigPath),
+				Description: "The path to the Linode config file to read when token is not set.",
+			},
+			"config_profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("LINODE_PROFILE", nil),
+				Description: "The Linode config file profile to read when token is not set.",
+			},
 			"url": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -38,6 +50,12 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("LINODE_API_VERSION", nil),
 				Description: "An HTTP User-Agent Prefix to prepend in API requests.",
 			},
+			"debug": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("LINODE_DEBUG", false),
+				Description: "Log raw API requests and responses with the Authorization header redacted.",
+			},
 
 			"skip_instance_ready_poll": {
 				Type:        schema.TypeBool,
@@ -46,6 +64,13 @@ func Provider() *schema.Provider {
 				Description: "Skip waiting for a linode_instance resource to be running.",
 			},
 
+			"skip_volume_ready_poll": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Skip waiting for a linode_volume resource to be active.",
+			},
+
 			"min_retry_delay_ms": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -71,18 +96,34 @@ func Provider() *schema.Provider {
 				Description: "The rate in milliseconds to poll for LKE events.",
 			},
 
+			"volume_event_poll_ms": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The rate in milliseconds to poll for Volume events. Defaults to event_poll_ms.",
+			},
+
 			"lke_node_ready_poll_ms": {
 				Type:        schema.TypeInt,
 				Optional:    true,
 				Default:     500,
 				Description: "The rate in milliseconds to poll for an LKE node to be ready.",
 			},
+
+			"list_page_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(25, 500),
+				Description: "The number of results to fetch per page when data sources list resources. " +
+					"Defaults to the Linode API default.",
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
 			"linode_account":                dataSourceLinodeAccount(),
+			"linode_account_events":         dataSourceLinodeAccountEvents(),
 			"linode_domain":                 dataSourceLinodeDomain(),
 			"linode_domain_record":          dataSourceLinodeDomainRecord(),
+			"linode_domain_zone_import":     dataSourceLinodeDomainZoneImport(),
 			"linode_firewall":               dataSourceLinodeFirewall(),
 			"linode_image":                  dataSourceLinodeImage(),
 			"linode_images":                 dataSourceLinodeImages(),
@@ -91,7 +132,10 @@ func Provider() *schema.Provider {
 			"linode_instance_type":          dataSourceLinodeInstanceType(),
 			"linode_kernel":                 dataSourceLinodeKernel(),
 			"linode_lke_cluster":            dataSourceLinodeLKECluster(),
+			"linode_lke_versions":           dataSourceLinodeLKEVersions(),
+			"linode_longview_subscription":  dataSourceLinodeLongviewSubscription(),
 			"linode_networking_ip":          dataSourceLinodeNetworkingIP(),
+			"linode_networking_ips":         dataSourceLinodeNetworkingIPs(),
 			"linode_nodebalancer":           dataSourceLinodeNodeBalancer(),
 			"linode_nodebalancer_config":    dataSourceLinodeNodeBalancerConfig(),
 			"linode_nodebalancer_node":      dataSourceLinodeNodeBalancerNode(),
@@ -106,25 +150,29 @@ func Provider() *schema.Provider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"linode_domain":                resourceLinodeDomain(),
-			"linode_domain_record":         resourceLinodeDomainRecord(),
-			"linode_firewall":              resourceLinodeFirewall(),
-			"linode_image":                 resourceLinodeImage(),
-			"linode_instance":              resourceLinodeInstance(),
-			"linode_instance_ip":           resourceLinodeInstanceIP(),
-			"linode_lke_cluster":           resourceLinodeLKECluster(),
-			"linode_nodebalancer":          resourceLinodeNodeBalancer(),
-			"linode_nodebalancer_config":   resourceLinodeNodeBalancerConfig(),
-			"linode_nodebalancer_node":     resourceLinodeNodeBalancerNode(),
-			"linode_object_storage_bucket": resourceLinodeObjectStorageBucket(),
-			"linode_object_storage_key":    resourceLinodeObjectStorageKey(),
-			"linode_object_storage_object": resourceLinodeObjectStorageObject(),
-			"linode_rdns":                  resourceLinodeRDNS(),
-			"linode_sshkey":                resourceLinodeSSHKey(),
-			"linode_stackscript":           resourceLinodeStackscript(),
-			"linode_token":                 resourceLinodeToken(),
-			"linode_user":                  resourceLinodeUser(),
-			"linode_volume":                resourceLinodeVolume(),
+			"linode_domain":                 resourceLinodeDomain(),
+			"linode_domain_record":          resourceLinodeDomainRecord(),
+			"linode_firewall":               resourceLinodeFirewall(),
+			"linode_firewall_device":        resourceLinodeFirewallDevice(),
+			"linode_image":                  resourceLinodeImage(),
+			"linode_instance":               resourceLinodeInstance(),
+			"linode_instance_ip":            resourceLinodeInstanceIP(),
+			"linode_instance_snapshot":      resourceLinodeInstanceSnapshot(),
+			"linode_lke_cluster":            resourceLinodeLKECluster(),
+			"linode_nodebalancer":           resourceLinodeNodeBalancer(),
+			"linode_nodebalancer_config":    resourceLinodeNodeBalancerConfig(),
+			"linode_nodebalancer_node":      resourceLinodeNodeBalancerNode(),
+			"linode_object_storage_bucket":  resourceLinodeObjectStorageBucket(),
+			"linode_object_storage_key":     resourceLinodeObjectStorageKey(),
+			"linode_object_storage_object":  resourceLinodeObjectStorageObject(),
+			"linode_object_storage_objects": resourceLinodeObjectStorageObjects(),
+			"linode_rdns":                   resourceLinodeRDNS(),
+			"linode_rdns_set":               resourceLinodeRDNSSet(),
+			"linode_sshkey":                 resourceLinodeSSHKey(),
+			"linode_stackscript":            resourceLinodeStackscript(),
+			"linode_token":                  resourceLinodeToken(),
+			"linode_user":                   resourceLinodeUser(),
+			"linode_volume":                 resourceLinodeVolume(),
 		},
 	}
 
@@ -151,8 +199,10 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		APIURL:      d.Get("url").(string),
 		APIVersion:  d.Get("api_version").(string),
 		UAPrefix:    d.Get("ua_prefix").(string),
+		Debug:       d.Get("debug").(bool),
 
 		SkipInstanceReadyPoll: d.Get("skip_instance_ready_poll").(bool),
+		SkipVolumeReadyPoll:   d.Get("skip_volume_ready_poll").(bool),
 
 		MinRetryDelayMilliseconds: d.Get("min_retry_delay_ms").(int),
 		MaxRetryDelayMilliseconds: d.Get("max_retry_delay_ms").(int),
@@ -160,13 +210,36 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		EventPollMilliseconds:    d.Get("event_poll_ms").(int),
 		LKEEventPollMilliseconds: d.Get("lke_event_poll_ms").(int),
 
+		VolumeEventPollMilliseconds: d.Get("volume_event_poll_ms").(int),
+
 		LKENodeReadyPollMilliseconds: d.Get("lke_node_ready_poll_ms").(int),
+
+		ListPageSize: d.Get("list_page_size").(int),
 	}
 	config.terraformVersion = terraformVersion
+
+	if config.AccessToken == "" {
+		profile, err := loadConfigProfile(d.Get("config_path").(string), d.Get("config_profile").(string))
+		if err != nil {
+			return nil, fmt.Errorf("A token must be set directly or through a config profile: %s", err)
+		}
+		if profile.Token == "" {
+			return nil, fmt.Errorf("The selected config profile does not set a token")
+		}
+
+		config.AccessToken = profile.Token
+		if config.APIURL == "" {
+			config.APIURL = profile.APIURL
+		}
+		if config.APIVersion == "" {
+			config.APIVersion = profile.APIVersion
+		}
+	}
+
 	client := config.Client()
 
-	// Ping the API for an empty response to verify the configuration works
-	if _, err := client.ListTypes(context.Background(), linodego.NewListOptions(100, "")); err != nil {
+	// Ping the API for a single page of types to verify the configuration works
+	if _, err := client.ListTypes(context.Background(), linodego.NewListOptions(1, "")); err != nil {
 		return nil, fmt.Errorf("Error connecting to the Linode API: %s", err)
 	}
 	return &ProviderMeta{
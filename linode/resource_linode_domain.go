@@ -22,6 +22,7 @@ func resourceLinodeDomain() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceLinodeDomainCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"domain": {
 				Type: schema.TypeString,
@@ -62,8 +63,9 @@ func resourceLinodeDomain() *schema.Resource {
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
-				Description: "The IP addresses representing the master DNS for this Domain.",
-				Optional:    true,
+				Description: "The IP addresses representing the master DNS for this Domain. Required for slave " +
+					"Domains and not allowed for master Domains.",
+				Optional: true,
 			},
 			"axfr_ips": {
 				Type: schema.TypeSet,
@@ -131,7 +133,7 @@ func resourceLinodeDomainRead(d *schema.ResourceData, meta interface{}) error {
 
 	domain, err := client.GetDomain(context.Background(), int(id))
 	if err != nil {
-		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+		if isLinodeNotFound(err) {
 			log.Printf("[WARN] removing Linode Domain ID %q from state because it no longer exists", d.Id())
 			d.SetId("")
 			return nil
@@ -145,9 +147,7 @@ func resourceLinodeDomainRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("status", domain.Status)
 	d.Set("description", domain.Description)
 	d.Set("master_ips", domain.MasterIPs)
-	if len(domain.AXfrIPs) > 0 {
-		d.Set("axfr_ips", domain.AXfrIPs)
-	}
+	d.Set("axfr_ips", domain.AXfrIPs)
 	d.Set("ttl_sec", domain.TTLSec)
 	d.Set("retry_sec", domain.RetrySec)
 	d.Set("expire_sec", domain.ExpireSec)
@@ -224,6 +224,9 @@ func resourceLinodeDomainUpdate(d *schema.ResourceData, meta interface{}) error
 		ExpireSec:   d.Get("expire_sec").(int),
 		RefreshSec:  d.Get("refresh_sec").(int),
 		TTLSec:      d.Get("ttl_sec").(int),
+
+		// DomainUpdateOptions always sends the tags, so the declared tags are sent with every update.
+		Tags: expandStringSet(d.Get("tags").(*schema.Set)),
 	}
 
 	if d.HasChange("master_ips") {
@@ -244,15 +247,6 @@ func resourceLinodeDomainUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	if d.HasChange("tags") {
-		tags := []string{}
-		for _, tag := range d.Get("tags").(*schema.Set).List() {
-			tags = append(tags, tag.(string))
-		}
-
-		updateOpts.Tags = tags
-	}
-
 	_, err = client.UpdateDomain(context.Background(), int(id), updateOpts)
 	if err != nil {
 		return fmt.Errorf("Error updating Linode Domain %d: %s", id, err)
@@ -275,27 +269,52 @@ func resourceLinodeDomainDelete(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func domainSecondsDiffSuppressor() schema.SchemaDiffSuppressFunc {
-	accepted := []int{
-		300, 3600, 7200, 14400, 28800, 57600, 86400, 172800, 345600, 604800, 1209600, 2419200,
+func resourceLinodeDomainCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("type") || !d.NewValueKnown("master_ips") {
+		return nil
 	}
 
-	rounder := func(n int) int {
-		if n == 0 {
-			return 0
+	hasMasterIPs := d.Get("master_ips").(*schema.Set).Len() > 0
+
+	switch d.Get("type").(string) {
+	case "slave":
+		if !hasMasterIPs {
+			return fmt.Errorf("master_ips is required for slave Domains")
+		}
+	case "master":
+		if hasMasterIPs {
+			return fmt.Errorf("master_ips is not allowed for master Domains")
 		}
+	}
+
+	return nil
+}
+
+// domainSecondsAccepted are the values the Linode API accepts for Domain and Domain Record
+// seconds fields. Any other value is rounded up to the next accepted value.
+var domainSecondsAccepted = []int{
+	300, 3600, 7200, 14400, 28800, 57600, 86400, 172800, 345600, 604800, 1209600, 2419200,
+}
+
+// roundDomainSeconds rounds n the same way the Linode API does. 0 is left as is,
+// as it selects the default value.
+func roundDomainSeconds(n int) int {
+	if n <= 0 {
+		return 0
+	}
 
-		for _, value := range accepted {
-			if n <= value {
-				return value
-			}
+	for _, value := range domainSecondsAccepted {
+		if n <= value {
+			return value
 		}
-		return accepted[len(accepted)-1]
 	}
+	return domainSecondsAccepted[len(domainSecondsAccepted)-1]
+}
 
+func domainSecondsDiffSuppressor() schema.SchemaDiffSuppressFunc {
 	return func(k, provisioned, declared string, d *schema.ResourceData) bool {
 		provisionedSec, _ := strconv.Atoi(provisioned)
 		declaredSec, _ := strconv.Atoi(declared)
-		return rounder(declaredSec) == provisionedSec
+		return roundDomainSeconds(declaredSec) == provisionedSec
 	}
 }
@@ -95,6 +95,16 @@ func resourceLinodeDomainRecord() *schema.Resource {
 				Description: "The relative weight of this Record. Higher values are preferred.",
 				Optional:    true,
 			},
+			"domain": {
+				Type:        schema.TypeString,
+				Description: "The name of the Domain this Record belongs to.",
+				Computed:    true,
+			},
+			"fqdn": {
+				Type:        schema.TypeString,
+				Description: "The fully qualified domain name of this Record.",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -137,7 +147,7 @@ func resourceLinodeDomainRecordRead(d *schema.ResourceData, meta interface{}) er
 	domainID := d.Get("domain_id").(int)
 	record, err := client.GetDomainRecord(context.Background(), int(domainID), int(id))
 	if err != nil {
-		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+		if isLinodeNotFound(err) {
 			log.Printf("[WARN] removing Linode Domain Record ID %q from state because it no longer exists", d.Id())
 			d.SetId("")
 			return nil
@@ -145,6 +155,12 @@ func resourceLinodeDomainRecordRead(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("Error finding the specified Linode DomainRecord: %s", err)
 	}
 
+	// the domain is read on every refresh, as it can be renamed in place
+	domain, err := client.GetDomain(context.Background(), domainID)
+	if err != nil {
+		return fmt.Errorf("Error finding the Linode Domain %d of DomainRecord %d: %s", domainID, id, err)
+	}
+
 	d.Set("name", record.Name)
 	d.Set("port", record.Port)
 	d.Set("priority", record.Priority)
@@ -155,10 +171,21 @@ func resourceLinodeDomainRecordRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("ttl_sec", record.TTLSec)
 	d.Set("record_type", record.Type)
 	d.Set("weight", record.Weight)
+	d.Set("domain", domain.Domain)
+	d.Set("fqdn", domainRecordFQDN(record.Name, domain.Domain))
 
 	return nil
 }
 
+// domainRecordFQDN returns the fully qualified domain name of a record with the given
+// name in the domain. Records with an empty name are at the apex of the domain.
+func domainRecordFQDN(name, domain string) string {
+	if name == "" {
+		return domain
+	}
+	return name + "." + domain
+}
+
 func resourceDataStringOrNil(d *schema.ResourceData, name string) *string {
 	if val, ok := d.GetOkExists(name); ok {
 		i := val.(string)
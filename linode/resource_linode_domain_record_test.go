@@ -28,6 +28,21 @@ func TestAccLinodeDomainRecord_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckLinodeDomainRecordExists,
 					resource.TestCheckResourceAttr(resName, "name", domainRecordName),
+					resource.TestCheckResourceAttr(resName, "domain", fmt.Sprintf("%s.example", domainRecordName)),
+					resource.TestCheckResourceAttr(resName, "fqdn",
+						fmt.Sprintf("%s.%s.example", domainRecordName, domainRecordName)),
+				),
+			},
+			{
+				Config: testAccCheckLinodeDomainRecordConfigRenamedDomain(domainRecordName),
+			},
+			{
+				// the record picks up the new domain name when it is refreshed
+				Config: testAccCheckLinodeDomainRecordConfigRenamedDomain(domainRecordName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "domain", fmt.Sprintf("renamed-%s.example", domainRecordName)),
+					resource.TestCheckResourceAttr(resName, "fqdn",
+						fmt.Sprintf("%s.renamed-%s.example", domainRecordName, domainRecordName)),
 				),
 			},
 			{
@@ -323,6 +338,16 @@ resource "linode_domain_record" "foobar" {
 }`, domainRecord, domainRecord)
 }
 
+func testAccCheckLinodeDomainRecordConfigRenamedDomain(domainRecord string) string {
+	return testAccCheckLinodeDomainConfigUpdates(domainRecord+".example") + fmt.Sprintf(`
+resource "linode_domain_record" "foobar" {
+	domain_id = "${linode_domain.foobar.id}"
+	name = "%s"
+	record_type = "CNAME"
+	target = "target.%s.example"
+}`, domainRecord, domainRecord)
+}
+
 func testAccCheckLinodeDomainRecordConfigWithTTL(domainRecord string, ttlSec int) string {
 	return testAccCheckLinodeDomainConfigBasic(domainRecord+".example") + fmt.Sprintf(`
 resource "linode_domain_record" "foobar" {
@@ -394,3 +419,41 @@ resource "linode_domain_record" "foobar" {
 	weight      = 0
 }`, target)
 }
+
+func TestDomainSecondsDiffSuppressor(t *testing.T) {
+	suppressor := domainSecondsDiffSuppressor()
+
+	for _, tc := range []struct {
+		provisioned, declared string
+		suppressed            bool
+	}{
+		{provisioned: "0", declared: "0", suppressed: true},
+		{provisioned: "300", declared: "0", suppressed: false},
+		{provisioned: "300", declared: "1", suppressed: true},
+		{provisioned: "300", declared: "300", suppressed: true},
+		{provisioned: "3600", declared: "301", suppressed: true},
+		{provisioned: "300", declared: "301", suppressed: false},
+		{provisioned: "7200", declared: "3601", suppressed: true},
+		{provisioned: "3600", declared: "3601", suppressed: false},
+		{provisioned: "2419200", declared: "9999999", suppressed: true},
+	} {
+		if got := suppressor("ttl_sec", tc.provisioned, tc.declared, nil); got != tc.suppressed {
+			t.Errorf("provisioned %s, declared %s: expected suppressed %t, got %t",
+				tc.provisioned, tc.declared, tc.suppressed, got)
+		}
+	}
+}
+
+func TestDomainRecordFQDN(t *testing.T) {
+	for _, tc := range []struct {
+		name, domain, expected string
+	}{
+		{name: "", domain: "example.com", expected: "example.com"},
+		{name: "www", domain: "example.com", expected: "www.example.com"},
+		{name: "_sip._tcp", domain: "example.com", expected: "_sip._tcp.example.com"},
+	} {
+		if got := domainRecordFQDN(tc.name, tc.domain); got != tc.expected {
+			t.Errorf("expected %q, got %q", tc.expected, got)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package linode
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -70,7 +71,7 @@ func TestAccLinodeDomain_basic(t *testing.T) {
 					resource.TestCheckNoResourceAttr(resName, "master_ips"),
 					resource.TestCheckNoResourceAttr(resName, "axfr_ips"),
 					resource.TestCheckResourceAttr(resName, "tags.#", "1"),
-					resource.TestCheckResourceAttr(resName, "tags.0", "tf_test"),
+					resource.TestCheckTypeSetElemAttr(resName, "tags.*", "tf_test"),
 				),
 			},
 
@@ -107,8 +108,8 @@ func TestAccLinodeDomain_update(t *testing.T) {
 					testAccCheckLinodeDomainExists,
 					resource.TestCheckResourceAttr(resName, "domain", fmt.Sprintf("renamed-%s", domainName)),
 					resource.TestCheckResourceAttr(resName, "tags.#", "2"),
-					resource.TestCheckResourceAttr(resName, "tags.0", "tf_test"),
-					resource.TestCheckResourceAttr(resName, "tags.1", "tf_test_2"),
+					resource.TestCheckTypeSetElemAttr(resName, "tags.*", "tf_test"),
+					resource.TestCheckTypeSetElemAttr(resName, "tags.*", "tf_test_2"),
 				),
 			},
 		},
@@ -213,7 +214,6 @@ func TestAccLinodeDomain_updateIPs(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckLinodeDomainExists,
 					resource.TestCheckResourceAttr(resName, "domain", domainName),
-					resource.TestCheckResourceAttr(resName, "master_ips.0", "12.34.56.78"),
 					resource.TestCheckResourceAttr(resName, "axfr_ips.0", "87.65.43.21"),
 				),
 			},
@@ -229,6 +229,70 @@ func TestAccLinodeDomain_updateIPs(t *testing.T) {
 	})
 }
 
+func TestAccLinodeDomain_slave(t *testing.T) {
+	t.Parallel()
+
+	var domainName = acctest.RandomWithPrefix("tf-test") + ".example"
+	var resName = "linode_domain.foobar"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckLinodeDomainConfigSlave(domainName, ""),
+				ExpectError: regexp.MustCompile("master_ips is required for slave Domains"),
+			},
+			{
+				Config: testAccCheckLinodeDomainConfigSlave(domainName, "12.34.56.78"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeDomainExists,
+					resource.TestCheckResourceAttr(resName, "type", "slave"),
+					resource.TestCheckResourceAttr(resName, "master_ips.#", "1"),
+					resource.TestCheckResourceAttr(resName, "master_ips.0", "12.34.56.78"),
+				),
+			},
+			{
+				Config: testAccCheckLinodeDomainConfigSlave(domainName, "87.65.43.21"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeDomainExists,
+					resource.TestCheckResourceAttr(resName, "master_ips.#", "1"),
+					resource.TestCheckResourceAttr(resName, "master_ips.0", "87.65.43.21"),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLinodeDomain_masterIPsOnMaster(t *testing.T) {
+	t.Parallel()
+
+	var domainName = acctest.RandomWithPrefix("tf-test") + ".example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "linode_domain" "foobar" {
+	domain = "%s"
+	type = "master"
+	soa_email = "example@%s"
+	master_ips = ["12.34.56.78"]
+}`, domainName, domainName),
+				ExpectError: regexp.MustCompile("master_ips is not allowed for master Domains"),
+			},
+		},
+	})
+}
+
 func testAccCheckLinodeDomainConfigBasic(domain string) string {
 	return fmt.Sprintf(`
 resource "linode_domain" "foobar" {
@@ -275,7 +339,6 @@ resource "linode_domain" "foobar" {
 	domain = "%s"
 	type = "master"
 	soa_email = "example@%s"
-	master_ips = ["12.34.56.78"]
 	axfr_ips = ["87.65.43.21"]
 }`, domain, domain)
 }
@@ -286,7 +349,20 @@ resource "linode_domain" "foobar" {
 	domain = "%s"
 	type = "master"
 	soa_email = "example@%s"
-	master_ips = []
 	axfr_ips = []
 }`, domain, domain)
 }
+
+func testAccCheckLinodeDomainConfigSlave(domain, masterIP string) string {
+	masterIPs := "[]"
+	if masterIP != "" {
+		masterIPs = fmt.Sprintf("[%q]", masterIP)
+	}
+
+	return fmt.Sprintf(`
+resource "linode_domain" "foobar" {
+	domain = "%s"
+	type = "slave"
+	master_ips = %s
+}`, domain, masterIPs)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -12,6 +14,31 @@ import (
 	"github.com/linode/linodego"
 )
 
+// linodeFirewallRulePreset is the protocol and ports a well-known rule preset expands to.
+type linodeFirewallRulePreset struct {
+	protocol linodego.NetworkProtocol
+	ports    string
+}
+
+var linodeFirewallRulePresets = map[string]linodeFirewallRulePreset{
+	"ssh":        {protocol: linodego.TCP, ports: "22"},
+	"http":       {protocol: linodego.TCP, ports: "80"},
+	"https":      {protocol: linodego.TCP, ports: "443"},
+	"dns":        {protocol: linodego.UDP, ports: "53"},
+	"mysql":      {protocol: linodego.TCP, ports: "3306"},
+	"postgresql": {protocol: linodego.TCP, ports: "5432"},
+	"rdp":        {protocol: linodego.TCP, ports: "3389"},
+}
+
+func linodeFirewallRulePresetNames() []string {
+	names := make([]string, 0, len(linodeFirewallRulePresets))
+	for name := range linodeFirewallRulePresets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 func resourceLinodeFirewallRule() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -33,16 +60,34 @@ func resourceLinodeFirewallRule() *schema.Resource {
 			},
 			"protocol": {
 				Type:        schema.TypeString,
-				Description: "The network protocol this rule controls.",
+				Description: "The network protocol this rule controls. Required unless preset is set.",
 				StateFunc: func(val interface{}) string {
 					return strings.ToUpper(val.(string))
 				},
-				Required: true,
+				Optional: true,
+			},
+			"preset": {
+				Type: schema.TypeString,
+				Description: "A well-known service whose standard protocol and ports this rule should use. " +
+					"Explicitly set protocol and ports take precedence over the preset.",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(linodeFirewallRulePresetNames(), false),
+			},
+			"resolved_protocol": {
+				Type:        schema.TypeString,
+				Description: "The network protocol this rule controls, including a protocol supplied by its preset.",
+				Computed:    true,
+			},
+			"resolved_ports": {
+				Type:        schema.TypeString,
+				Description: "The ports this rule controls, including ports supplied by its preset.",
+				Computed:    true,
 			},
 			"ipv4": {
 				Type: schema.TypeList,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:         schema.TypeString,
+					ValidateFunc: validateLinodeFirewallRuleAddress(false),
 				},
 				Description: "A list of IP addresses, CIDR blocks, or 0.0.0.0/0 (to allow all) this rule applies to.",
 				Optional:    true,
@@ -50,7 +95,8 @@ func resourceLinodeFirewallRule() *schema.Resource {
 			"ipv6": {
 				Type: schema.TypeList,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:         schema.TypeString,
+					ValidateFunc: validateLinodeFirewallRuleAddress(true),
 				},
 				Description: "A list of IPv6 addresses or networks this rule applies to.",
 				MinItems:    1,
@@ -60,7 +106,7 @@ func resourceLinodeFirewallRule() *schema.Resource {
 	}
 }
 
-func resourceLinodeFirewallDevice() *schema.Resource {
+func resourceLinodeFirewallGovernedDevice() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -101,6 +147,7 @@ func resourceLinodeFirewall() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceLinodeFirewallCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"label": {
 				Type: schema.TypeString,
@@ -155,7 +202,7 @@ func resourceLinodeFirewall() *schema.Resource {
 			},
 			"devices": {
 				Type:        schema.TypeList,
-				Elem:        resourceLinodeFirewallDevice(),
+				Elem:        resourceLinodeFirewallGovernedDevice(),
 				Computed:    true,
 				Description: "The devices associated with this firewall.",
 			},
@@ -168,6 +215,65 @@ func resourceLinodeFirewall() *schema.Resource {
 	}
 }
 
+func resourceLinodeFirewallCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return validateLinodeFirewallRules(d, "")
+}
+
+// validateLinodeFirewallRuleAddress returns a validator for the IPv4 or IPv6 addresses of a Firewall rule. Each
+// address must be a single IP or a network in CIDR notation without host bits set, e.g. 10.0.0.0/8 but not
+// 10.0.0.1/8.
+func validateLinodeFirewallRuleAddress(ipv6 bool) schema.SchemaValidateFunc {
+	family := "IPv4"
+	if ipv6 {
+		family = "IPv6"
+	}
+
+	return func(i interface{}, k string) (warnings []string, errs []error) {
+		address, ok := i.(string)
+		if !ok {
+			return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+		}
+
+		ip, network, err := net.ParseCIDR(address)
+		if err != nil {
+			if ip = net.ParseIP(address); ip == nil {
+				return nil, []error{fmt.Errorf("%s: %q is not a valid %s address or CIDR", k, address, family)}
+			}
+		}
+
+		if isIPv4 := ip.To4() != nil && !strings.Contains(address, ":"); isIPv4 == ipv6 {
+			return nil, []error{fmt.Errorf("%s: %q is not an %s address or CIDR", k, address, family)}
+		}
+
+		if network != nil && !ip.Equal(network.IP) {
+			return nil, []error{fmt.Errorf("%s: %q has host bits set, did you mean %q?", k, address, network.String())}
+		}
+		return nil, nil
+	}
+}
+
+// validateLinodeFirewallRules ensures that every rule under the given attribute path prefix
+// sets either a protocol or a preset.
+func validateLinodeFirewallRules(d *schema.ResourceDiff, prefix string) error {
+	for _, direction := range []string{"inbound", "outbound"} {
+		rules, _ := d.Get(prefix + direction).([]interface{})
+		for i, ruleSpec := range rules {
+			ruleSpec, ok := ruleSpec.(map[string]interface{})
+			if !ok || ruleSpec["protocol"].(string) != "" || ruleSpec["preset"].(string) != "" {
+				continue
+			}
+
+			if !d.NewValueKnown(fmt.Sprintf("%s%s.%d.protocol", prefix, direction, i)) ||
+				!d.NewValueKnown(fmt.Sprintf("%s%s.%d.preset", prefix, direction, i)) {
+				continue
+			}
+
+			return fmt.Errorf("%s rule %q must set either protocol or preset", direction, ruleSpec["label"])
+		}
+	}
+	return nil
+}
+
 func resourceLinodeFirewallRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ProviderMeta).Client
 	id, err := strconv.Atoi(d.Id())
@@ -194,8 +300,10 @@ func resourceLinodeFirewallRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("disabled", firewall.Status == linodego.FirewallDisabled)
 	d.Set("tags", firewall.Tags)
 	d.Set("status", firewall.Status)
-	d.Set("inbound", flattenLinodeFirewallRules(rules.Inbound))
-	d.Set("outbound", flattenLinodeFirewallRules(rules.Outbound))
+	d.Set("inbound", applyLinodeFirewallRulePresets(
+		flattenLinodeFirewallRules(rules.Inbound), d.Get("inbound").([]interface{})))
+	d.Set("outbound", applyLinodeFirewallRulePresets(
+		flattenLinodeFirewallRules(rules.Outbound), d.Get("outbound").([]interface{})))
 	d.Set("inbound_policy", firewall.Rules.InboundPolicy)
 	d.Set("outbound_policy", firewall.Rules.OutboundPolicy)
 	d.Set("linodes", flattenLinodeFirewallLinodes(devices))
@@ -211,7 +319,14 @@ func resourceLinodeFirewallCreate(d *schema.ResourceData, meta interface{}) erro
 		Tags:  expandStringSet(d.Get("tags").(*schema.Set)),
 	}
 
-	createOpts.Devices.Linodes = expandIntSet(d.Get("linodes").(*schema.Set))
+	linodes := expandIntSet(d.Get("linodes").(*schema.Set))
+	disabled := d.Get("disabled").(bool)
+
+	// A disabled Firewall is attached to its Linodes only once it is disabled, so that the staged rules
+	// never apply to them.
+	if !disabled {
+		createOpts.Devices.Linodes = linodes
+	}
 	createOpts.Rules.Inbound = expandLinodeFirewallRules(d.Get("inbound").([]interface{}))
 	createOpts.Rules.InboundPolicy = d.Get("inbound_policy").(string)
 	createOpts.Rules.Outbound = expandLinodeFirewallRules(d.Get("outbound").([]interface{}))
@@ -223,15 +338,24 @@ func resourceLinodeFirewallCreate(d *schema.ResourceData, meta interface{}) erro
 
 	firewall, err := client.CreateFirewall(context.Background(), createOpts)
 	if err != nil {
-		return fmt.Errorf("failed to create Firewall: %s", err)
+		return fmt.Errorf("failed to create Firewall: %s", formatLinodeError(err))
 	}
 	d.SetId(strconv.Itoa(firewall.ID))
 
-	if d.Get("disabled").(bool) {
+	if disabled {
 		if _, err := client.UpdateFirewall(context.Background(), firewall.ID, linodego.FirewallUpdateOptions{
 			Status: linodego.FirewallDisabled,
 		}); err != nil {
-			return fmt.Errorf("failed to disable firewall %d: %s", firewall.ID, err)
+			return fmt.Errorf("failed to disable firewall %d: %s", firewall.ID, formatLinodeError(err))
+		}
+
+		for _, linodeID := range linodes {
+			if _, err := client.CreateFirewallDevice(context.Background(), firewall.ID, linodego.FirewallDeviceCreateOptions{
+				ID:   linodeID,
+				Type: linodego.FirewallDeviceLinode,
+			}); err != nil {
+				return fmt.Errorf("failed to create firewall device for linode %d: %s", linodeID, formatLinodeError(err))
+			}
 		}
 	}
 
@@ -245,21 +369,26 @@ func resourceLinodeFirewallUpdate(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("failed to parse Firewall %s as int: %s", d.Id(), err)
 	}
 
-	if d.HasChanges("label", "tags", "disabled") {
+	if d.HasChange("tags") {
+		oldTags, newTags := d.GetChange("tags")
+		err := updateResourceTags(context.Background(), client, linodego.EntityFirewall, id,
+			expandStringSet(oldTags.(*schema.Set)), expandStringSet(newTags.(*schema.Set)))
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.HasChanges("label", "disabled") {
 		updateOpts := linodego.FirewallUpdateOptions{}
 		if d.HasChange("label") {
 			updateOpts.Label = d.Get("label").(string)
 		}
-		if d.HasChange("tags") {
-			tags := expandStringSet(d.Get("tags").(*schema.Set))
-			updateOpts.Tags = &tags
-		}
 		if d.HasChange("disabled") {
 			updateOpts.Status = expandLinodeFirewallStatus(d.Get("disabled"))
 		}
 
 		if _, err := client.UpdateFirewall(context.Background(), id, updateOpts); err != nil {
-			return fmt.Errorf("failed to update firewall %d: %s", id, err)
+			return fmt.Errorf("failed to update firewall %d: %s", id, formatLinodeError(err))
 		}
 	}
 
@@ -272,7 +401,7 @@ func resourceLinodeFirewallUpdate(d *schema.ResourceData, meta interface{}) erro
 		OutboundPolicy: d.Get("outbound_policy").(string),
 	}
 	if _, err := client.UpdateFirewallRules(context.Background(), id, ruleSet); err != nil {
-		return fmt.Errorf("failed to update rules for firewall %d: %s", id, err)
+		return fmt.Errorf("failed to update rules for firewall %d: %s", id, formatLinodeError(err))
 	}
 
 	linodes := expandIntSet(d.Get("linodes").(*schema.Set))
@@ -297,7 +426,7 @@ func resourceLinodeFirewallUpdate(d *schema.ResourceData, meta interface{}) erro
 				ID:   linodeID,
 				Type: linodego.FirewallDeviceLinode,
 			}); err != nil {
-				return fmt.Errorf("failed to create firewall device for linode %d: %s", linodeID, err)
+				return fmt.Errorf("failed to create firewall device for linode %d: %s", linodeID, formatLinodeError(err))
 			}
 		}
 
@@ -341,6 +470,15 @@ func expandLinodeFirewallRules(ruleSpecs []interface{}) []linodego.FirewallRule
 		rule.Protocol = linodego.NetworkProtocol(strings.ToUpper(ruleSpec["protocol"].(string)))
 		rule.Ports = ruleSpec["ports"].(string)
 
+		if preset, ok := linodeFirewallRulePresets[ruleSpec["preset"].(string)]; ok {
+			if rule.Protocol == "" {
+				rule.Protocol = preset.protocol
+			}
+			if rule.Ports == "" {
+				rule.Ports = preset.ports
+			}
+		}
+
 		ipv4 := expandStringList(ruleSpec["ipv4"].([]interface{}))
 		if len(ipv4) > 0 {
 			rule.Addresses.IPv4 = &ipv4
@@ -369,6 +507,38 @@ func flattenLinodeFirewallRules(rules []linodego.FirewallRule) []map[string]inte
 	return specs
 }
 
+// applyLinodeFirewallRulePresets carries the preset of each configured rule over to the
+// corresponding flattened rule, as presets are not returned by the API. The protocol and
+// ports filled in by a preset are left unset so that they match the configuration; values
+// that no longer match the preset are kept so the drift shows up in the plan. The protocol
+// and ports returned by the API are always kept as resolved_protocol and resolved_ports.
+func applyLinodeFirewallRulePresets(rules []map[string]interface{}, ruleSpecs []interface{}) []map[string]interface{} {
+	for i, rule := range rules {
+		rule["resolved_protocol"] = rule["protocol"]
+		rule["resolved_ports"] = rule["ports"]
+
+		if i >= len(ruleSpecs) || ruleSpecs[i] == nil {
+			continue
+		}
+
+		ruleSpec := ruleSpecs[i].(map[string]interface{})
+		name := ruleSpec["preset"].(string)
+		preset, ok := linodeFirewallRulePresets[name]
+		if !ok {
+			continue
+		}
+
+		rule["preset"] = name
+		if ruleSpec["protocol"].(string) == "" && rule["protocol"] == preset.protocol {
+			rule["protocol"] = ""
+		}
+		if ruleSpec["ports"].(string) == "" && rule["ports"] == preset.ports {
+			rule["ports"] = ""
+		}
+	}
+	return rules
+}
+
 func flattenLinodeFirewallLinodes(devices []linodego.FirewallDevice) []int {
 	linodes := make([]int, 0, len(devices))
 	for _, device := range devices {
@@ -0,0 +1,144 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/linode/linodego"
+)
+
+func resourceLinodeFirewallDevice() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLinodeFirewallDeviceCreate,
+		ReadContext:   resourceLinodeFirewallDeviceRead,
+		DeleteContext: resourceLinodeFirewallDeviceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceLinodeFirewallDeviceImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"firewall_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the Firewall to attach the device to.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"entity_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the entity to attach to the Firewall (e.g. the Linode's ID).",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"entity_type": {
+				Type:        schema.TypeString,
+				Description: "The type of the entity to attach to the Firewall.",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     string(linodego.FirewallDeviceLinode),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(linodego.FirewallDeviceLinode),
+					string(linodego.FirewallDeviceNodeBalancer),
+				}, false),
+			},
+			"label": {
+				Type:        schema.TypeString,
+				Description: "The label of the underlying entity for the firewall device.",
+				Computed:    true,
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Description: "The URL of the underlying entity for the firewall device.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceLinodeFirewallDeviceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf("failed to parse Firewall Device %s as int: %s", d.Id(), err)
+	}
+
+	firewallID := d.Get("firewall_id").(int)
+	device, err := client.GetFirewallDevice(ctx, firewallID, id)
+	if err != nil {
+		if isLinodeNotFound(err) {
+			log.Printf("[WARN] removing Firewall (%d) Device %q from state because it no longer exists", firewallID, d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("failed to get firewall (%d) device %d: %s", firewallID, id, err)
+	}
+
+	d.Set("entity_id", device.Entity.ID)
+	d.Set("entity_type", device.Entity.Type)
+	d.Set("label", device.Entity.Label)
+	d.Set("url", device.Entity.URL)
+	return nil
+}
+
+func resourceLinodeFirewallDeviceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+
+	firewallID := d.Get("firewall_id").(int)
+	createOpts := linodego.FirewallDeviceCreateOptions{
+		ID:   d.Get("entity_id").(int),
+		Type: linodego.FirewallDeviceType(d.Get("entity_type").(string)),
+	}
+
+	device, err := client.CreateFirewallDevice(ctx, firewallID, createOpts)
+	if err != nil {
+		return diag.Errorf("failed to create firewall (%d) device for %s %d: %s",
+			firewallID, createOpts.Type, createOpts.ID, err)
+	}
+
+	d.SetId(strconv.Itoa(device.ID))
+	return resourceLinodeFirewallDeviceRead(ctx, d, meta)
+}
+
+func resourceLinodeFirewallDeviceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf("failed to parse Firewall Device %s as int: %s", d.Id(), err)
+	}
+
+	firewallID := d.Get("firewall_id").(int)
+	if err := client.DeleteFirewallDevice(ctx, firewallID, id); err != nil {
+		if isLinodeNotFound(err) {
+			return nil
+		}
+		return diag.Errorf("failed to delete firewall (%d) device %d: %s", firewallID, id, err)
+	}
+	return nil
+}
+
+func resourceLinodeFirewallDeviceImport(
+	ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	s := strings.Split(d.Id(), ",")
+	if len(s) != 2 {
+		return nil, fmt.Errorf("invalid firewall_device ID %q: expected <firewall_id>,<device_id>", d.Id())
+	}
+
+	firewallID, err := strconv.Atoi(s[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid firewall ID: %v", err)
+	}
+
+	if _, err := strconv.Atoi(s[1]); err != nil {
+		return nil, fmt.Errorf("invalid firewall_device ID: %v", err)
+	}
+
+	d.SetId(s[1])
+	d.Set("firewall_id", firewallID)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,78 @@
+package linode
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const testFirewallDeviceResName = "linode_firewall_device.test"
+
+func TestAccLinodeFirewallDevice_basic(t *testing.T) {
+	t.Parallel()
+
+	name := acctest.RandomWithPrefix("tf_test")
+	devicePrefix := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: accTestWithProvider(testAccCheckLinodeFirewallDeviceBasic(name, devicePrefix), map[string]interface{}{
+					providerKeySkipInstanceReadyPoll: true,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testFirewallDeviceResName, "entity_type", "linode"),
+					resource.TestCheckResourceAttrPair(testFirewallDeviceResName, "entity_id", "linode_instance.one", "id"),
+					resource.TestCheckResourceAttrPair(testFirewallDeviceResName, "firewall_id", testFirewallResName, "id"),
+					resource.TestCheckResourceAttrSet(testFirewallDeviceResName, "label"),
+					resource.TestCheckResourceAttrSet(testFirewallDeviceResName, "url"),
+				),
+			},
+			{
+				ResourceName:      testFirewallDeviceResName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccLinodeFirewallDeviceImportID,
+			},
+		},
+	})
+}
+
+func testAccLinodeFirewallDeviceImportID(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources[testFirewallDeviceResName]
+	if !ok {
+		return "", fmt.Errorf("Not found: %s", testFirewallDeviceResName)
+	}
+	return fmt.Sprintf("%s,%s", rs.Primary.Attributes["firewall_id"], rs.Primary.ID), nil
+}
+
+func testAccCheckLinodeFirewallDeviceBasic(name, devicePrefix string) string {
+	return testAccCheckLinodeFirewallInstance(devicePrefix, "one") + fmt.Sprintf(`
+resource "linode_firewall" "test" {
+	label = "%s"
+	tags  = ["test"]
+
+	inbound {
+		label    = "tf-test-in"
+		action   = "ACCEPT"
+		protocol = "tcp"
+		ipv4     = ["0.0.0.0/0"]
+	}
+	inbound_policy  = "DROP"
+	outbound_policy = "DROP"
+
+	lifecycle {
+		ignore_changes = [linodes]
+	}
+}
+
+resource "linode_firewall_device" "test" {
+	firewall_id = linode_firewall.test.id
+	entity_id   = linode_instance.one.id
+}`, name)
+}
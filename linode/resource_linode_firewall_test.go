@@ -3,10 +3,15 @@ package linode
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/linode/linodego"
 )
 
 const testFirewallResName = "linode_firewall.test"
@@ -40,6 +45,36 @@ func testSweepLinodeFirewall(prefix string) error {
 	return nil
 }
 
+func TestValidateLinodeFirewallRuleAddress(t *testing.T) {
+	for _, tc := range []struct {
+		address string
+		ipv6    bool
+		valid   bool
+	}{
+		{address: "0.0.0.0/0", valid: true},
+		{address: "10.0.0.0/8", valid: true},
+		{address: "192.0.2.1", valid: true},
+		{address: "192.0.2.1/32", valid: true},
+		{address: "::/0", ipv6: true, valid: true},
+		{address: "2001:db8::/32", ipv6: true, valid: true},
+		{address: "2001:db8::1", ipv6: true, valid: true},
+
+		{address: "10.0.0.1/8"},
+		{address: "2001:db8::1/32", ipv6: true},
+		{address: "10.0.0.0/33"},
+		{address: "not-an-ip"},
+		{address: ""},
+		{address: "::/0"},
+		{address: "0.0.0.0/0", ipv6: true},
+		{address: "::ffff:192.0.2.1"},
+	} {
+		_, errs := validateLinodeFirewallRuleAddress(tc.ipv6)(tc.address, "ipv4")
+		if valid := len(errs) == 0; valid != tc.valid {
+			t.Errorf("expected %q (ipv6=%t) to be valid=%t, got errors %v", tc.address, tc.ipv6, tc.valid, errs)
+		}
+	}
+}
+
 func TestAccLinodeFirewall_basic(t *testing.T) {
 	t.Parallel()
 
@@ -79,7 +114,7 @@ func TestAccLinodeFirewall_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(testFirewallResName, "devices.0.type", "linode"),
 					resource.TestCheckResourceAttr(testFirewallResName, "linodes.#", "1"),
 					resource.TestCheckResourceAttr(testFirewallResName, "tags.#", "1"),
-					resource.TestCheckResourceAttr(testFirewallResName, "tags.0", "test"),
+					resource.TestCheckTypeSetElemAttr(testFirewallResName, "tags.*", "test"),
 					resource.TestCheckResourceAttrSet(testFirewallResName, "devices.0.url"),
 					resource.TestCheckResourceAttrSet(testFirewallResName, "devices.0.id"),
 					resource.TestCheckResourceAttrSet(testFirewallResName, "devices.0.entity_id"),
@@ -122,18 +157,97 @@ func TestAccLinodeFirewall_minimum(t *testing.T) {
 					resource.TestCheckResourceAttr(testFirewallResName, "devices.#", "0"),
 					resource.TestCheckResourceAttr(testFirewallResName, "linodes.#", "0"),
 					resource.TestCheckResourceAttr(testFirewallResName, "tags.#", "1"),
-					resource.TestCheckResourceAttr(testFirewallResName, "tags.0", "test"),
+					resource.TestCheckTypeSetElemAttr(testFirewallResName, "tags.*", "test"),
+				),
+			},
+			{
+				ResourceName:      testFirewallResName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLinodeFirewall_presets(t *testing.T) {
+	t.Parallel()
+
+	name := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckLinodeFirewallNoProtocol(name),
+				ExpectError: regexp.MustCompile(`inbound rule "tf-test-in" must set either protocol or preset`),
+			},
+			{
+				Config: testAccCheckLinodeFirewallPresets(name, "ssh"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testFirewallResName, "inbound.#", "2"),
+					resource.TestCheckResourceAttr(testFirewallResName, "inbound.0.preset", "ssh"),
+					resource.TestCheckResourceAttr(testFirewallResName, "inbound.0.protocol", ""),
+					resource.TestCheckResourceAttr(testFirewallResName, "inbound.0.ports", ""),
+					resource.TestCheckResourceAttr(testFirewallResName, "inbound.1.preset", "https"),
+					resource.TestCheckResourceAttr(testFirewallResName, "inbound.1.protocol", ""),
+					resource.TestCheckResourceAttr(testFirewallResName, "inbound.1.ports", "8443"),
+					resource.TestCheckResourceAttr(testFirewallResName, "inbound.0.resolved_protocol", "TCP"),
+					resource.TestCheckResourceAttr(testFirewallResName, "inbound.0.resolved_ports", "22"),
+					resource.TestCheckResourceAttr(testFirewallResName, "inbound.1.resolved_protocol", "TCP"),
+					resource.TestCheckResourceAttr(testFirewallResName, "inbound.1.resolved_ports", "8443"),
+					testAccCheckLinodeFirewallInboundRule(0, "TCP", "22"),
+					testAccCheckLinodeFirewallInboundRule(1, "TCP", "8443"),
+				),
+			},
+			{
+				Config: testAccCheckLinodeFirewallPresets(name, "dns"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testFirewallResName, "inbound.0.preset", "dns"),
+					resource.TestCheckResourceAttr(testFirewallResName, "inbound.0.resolved_protocol", "UDP"),
+					resource.TestCheckResourceAttr(testFirewallResName, "inbound.0.resolved_ports", "53"),
+					testAccCheckLinodeFirewallInboundRule(0, "UDP", "53"),
 				),
 			},
 			{
 				ResourceName:      testFirewallResName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"inbound.0.preset", "inbound.0.protocol", "inbound.0.ports",
+					"inbound.1.preset", "inbound.1.protocol",
+				},
 			},
 		},
 	})
 }
 
+func TestApplyLinodeFirewallRulePresets(t *testing.T) {
+	rules := []map[string]interface{}{
+		{"protocol": linodego.TCP, "ports": "22"},
+		{"protocol": linodego.TCP, "ports": "8443"},
+		{"protocol": linodego.TCP, "ports": "22"},
+		{"protocol": linodego.TCP, "ports": "80"},
+	}
+	ruleSpecs := []interface{}{
+		map[string]interface{}{"preset": "ssh", "protocol": "", "ports": ""},
+		map[string]interface{}{"preset": "https", "protocol": "", "ports": "8443"},
+		map[string]interface{}{"preset": "dns", "protocol": "TCP", "ports": ""},
+		map[string]interface{}{"preset": "", "protocol": "TCP", "ports": "80"},
+	}
+
+	expected := []map[string]interface{}{
+		{"preset": "ssh", "protocol": "", "ports": "", "resolved_protocol": linodego.TCP, "resolved_ports": "22"},
+		{"preset": "https", "protocol": "", "ports": "8443", "resolved_protocol": linodego.TCP, "resolved_ports": "8443"},
+		{"preset": "dns", "protocol": linodego.TCP, "ports": "22", "resolved_protocol": linodego.TCP, "resolved_ports": "22"},
+		{"protocol": linodego.TCP, "ports": "80", "resolved_protocol": linodego.TCP, "resolved_ports": "80"},
+	}
+
+	if result := applyLinodeFirewallRulePresets(rules, ruleSpecs); !reflect.DeepEqual(result, expected) {
+		t.Fatalf("expected %v, got %v", expected, result)
+	}
+}
+
 func TestAccLinodeFirewall_multipleRules(t *testing.T) {
 	t.Parallel()
 
@@ -192,7 +306,7 @@ func TestAccLinodeFirewall_multipleRules(t *testing.T) {
 					resource.TestCheckResourceAttr(testFirewallResName, "devices.0.type", "linode"),
 					resource.TestCheckResourceAttr(testFirewallResName, "linodes.#", "1"),
 					resource.TestCheckResourceAttr(testFirewallResName, "tags.#", "1"),
-					resource.TestCheckResourceAttr(testFirewallResName, "tags.0", "test"),
+					resource.TestCheckTypeSetElemAttr(testFirewallResName, "tags.*", "test"),
 					resource.TestCheckResourceAttrSet(testFirewallResName, "devices.0.url"),
 					resource.TestCheckResourceAttrSet(testFirewallResName, "devices.0.id"),
 					resource.TestCheckResourceAttrSet(testFirewallResName, "devices.0.entity_id"),
@@ -234,7 +348,7 @@ func TestAccLinodeFirewall_no_device(t *testing.T) {
 					resource.TestCheckResourceAttr(testFirewallResName, "devices.#", "0"),
 					resource.TestCheckResourceAttr(testFirewallResName, "linodes.#", "0"),
 					resource.TestCheckResourceAttr(testFirewallResName, "tags.#", "1"),
-					resource.TestCheckResourceAttr(testFirewallResName, "tags.0", "test"),
+					resource.TestCheckTypeSetElemAttr(testFirewallResName, "tags.*", "test"),
 				),
 			},
 			{
@@ -287,7 +401,7 @@ func TestAccLinodeFirewall_updates(t *testing.T) {
 					resource.TestCheckResourceAttr(testFirewallResName, "devices.0.type", "linode"),
 					resource.TestCheckResourceAttr(testFirewallResName, "linodes.#", "1"),
 					resource.TestCheckResourceAttr(testFirewallResName, "tags.#", "1"),
-					resource.TestCheckResourceAttr(testFirewallResName, "tags.0", "test"),
+					resource.TestCheckTypeSetElemAttr(testFirewallResName, "tags.*", "test"),
 				),
 			},
 			{
@@ -324,10 +438,57 @@ func TestAccLinodeFirewall_updates(t *testing.T) {
 					resource.TestCheckResourceAttr(testFirewallResName, "outbound.#", "0"),
 					resource.TestCheckResourceAttr(testFirewallResName, "linodes.#", "1"),
 					resource.TestCheckResourceAttr(testFirewallResName, "tags.#", "2"),
-					resource.TestCheckResourceAttr(testFirewallResName, "tags.0", "test"),
-					resource.TestCheckResourceAttr(testFirewallResName, "tags.1", "test2"),
+					resource.TestCheckTypeSetElemAttr(testFirewallResName, "tags.*", "test"),
+					resource.TestCheckTypeSetElemAttr(testFirewallResName, "tags.*", "test2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLinodeFirewall_createDisabled(t *testing.T) {
+	t.Parallel()
+
+	name := acctest.RandomWithPrefix("tf_test")
+	devicePrefix := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeLKEClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: accTestWithProvider(testAccCheckLinodeFirewallDisabled(name, devicePrefix, true), map[string]interface{}{
+					providerKeySkipInstanceReadyPoll: true,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testFirewallResName, "label", name),
+					resource.TestCheckResourceAttr(testFirewallResName, "disabled", "true"),
+					resource.TestCheckResourceAttr(testFirewallResName, "status", "disabled"),
+					resource.TestCheckResourceAttr(testFirewallResName, "inbound.#", "1"),
+					resource.TestCheckResourceAttr(testFirewallResName, "outbound.#", "1"),
+					resource.TestCheckResourceAttr(testFirewallResName, "devices.#", "1"),
+					resource.TestCheckResourceAttr(testFirewallResName, "linodes.#", "1"),
+				),
+			},
+			{
+				Config: accTestWithProvider(testAccCheckLinodeFirewallDisabled(name, devicePrefix, false), map[string]interface{}{
+					providerKeySkipInstanceReadyPoll: true,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testFirewallResName, "disabled", "false"),
+					resource.TestCheckResourceAttr(testFirewallResName, "status", "enabled"),
+					resource.TestCheckResourceAttr(testFirewallResName, "inbound.#", "1"),
+					resource.TestCheckResourceAttr(testFirewallResName, "outbound.#", "1"),
+					resource.TestCheckResourceAttr(testFirewallResName, "linodes.#", "1"),
 				),
 			},
+			{
+				Config: accTestWithProvider(testAccCheckLinodeFirewallDisabled(name, devicePrefix, false), map[string]interface{}{
+					providerKeySkipInstanceReadyPoll: true,
+				}),
+				PlanOnly: true,
+			},
 		},
 	})
 }
@@ -396,6 +557,78 @@ resource "linode_firewall" "test" {
 }`, name)
 }
 
+func testAccCheckLinodeFirewallPresets(name, preset string) string {
+	return fmt.Sprintf(`
+resource "linode_firewall" "test" {
+	label = "%s"
+
+	inbound {
+		label  = "tf-test-preset"
+		action = "ACCEPT"
+		preset = "%s"
+		ipv4   = ["0.0.0.0/0"]
+	}
+
+	inbound {
+		label  = "tf-test-https"
+		action = "ACCEPT"
+		preset = "https"
+		ports  = "8443"
+		ipv4   = ["0.0.0.0/0"]
+	}
+	inbound_policy = "DROP"
+	outbound_policy = "ACCEPT"
+}`, name, preset)
+}
+
+func testAccCheckLinodeFirewallNoProtocol(name string) string {
+	return fmt.Sprintf(`
+resource "linode_firewall" "test" {
+	label = "%s"
+
+	inbound {
+		label  = "tf-test-in"
+		action = "ACCEPT"
+		ports  = "22"
+		ipv4   = ["0.0.0.0/0"]
+	}
+	inbound_policy = "DROP"
+	outbound_policy = "ACCEPT"
+}`, name)
+}
+
+func testAccCheckLinodeFirewallInboundRule(index int, protocol, ports string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*ProviderMeta).Client
+
+		rs, ok := s.RootModule().Resources[testFirewallResName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", testFirewallResName)
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("failed to parse firewall id %s: %s", rs.Primary.ID, err)
+		}
+
+		rules, err := client.GetFirewallRules(context.Background(), id)
+		if err != nil {
+			return fmt.Errorf("failed to get rules for firewall %d: %s", id, err)
+		}
+
+		if index >= len(rules.Inbound) {
+			return fmt.Errorf("firewall %d has no inbound rule %d", id, index)
+		}
+
+		rule := rules.Inbound[index]
+		if string(rule.Protocol) != protocol || rule.Ports != ports {
+			return fmt.Errorf("expected inbound rule %d to be %s %s, got %s %s",
+				index, protocol, ports, rule.Protocol, rule.Ports)
+		}
+		return nil
+	}
+}
+
 func testAccCheckLinodeFirewallMultipleRules(name, devicePrefix string) string {
 	return testAccCheckLinodeFirewallInstance(devicePrefix, "one") + fmt.Sprintf(`
 resource "linode_firewall" "test" {
@@ -444,6 +677,35 @@ resource "linode_firewall" "test" {
 }`, name)
 }
 
+func testAccCheckLinodeFirewallDisabled(name, devicePrefix string, disabled bool) string {
+	return testAccCheckLinodeFirewallInstance(devicePrefix, "one") + fmt.Sprintf(`
+resource "linode_firewall" "test" {
+	label    = "%s"
+	tags     = ["test"]
+	disabled = %t
+
+	inbound {
+		label    = "tf-test-in"
+		action   = "ACCEPT"
+		protocol = "TCP"
+		ports    = "80"
+		ipv4     = ["0.0.0.0/0"]
+	}
+	inbound_policy = "DROP"
+
+	outbound {
+		label    = "tf-test-out"
+		action   = "ACCEPT"
+		protocol = "TCP"
+		ports    = "80"
+		ipv4     = ["0.0.0.0/0"]
+	}
+	outbound_policy = "DROP"
+
+	linodes = [linode_instance.one.id]
+}`, name, disabled)
+}
+
 func testAccCheckLinodeFirewallNoDevice(name string) string {
 	return fmt.Sprintf(`
 resource "linode_firewall" "test" {
@@ -7,6 +7,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"io"
+	"log"
 	"os"
 	"time"
 
@@ -25,6 +26,7 @@ func resourceLinodeImage() *schema.Resource {
 		ReadContext:   resourceLinodeImageRead,
 		UpdateContext: resourceLinodeImageUpdate,
 		DeleteContext: resourceLinodeImageDelete,
+		CustomizeDiff: resourceLinodeImageCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -203,9 +205,51 @@ func resourceLinodeImageCreateFromLinode(
 			"failed to wait for linode instance %d disk %d to become ready while taking an image", linodeID, diskID)
 	}
 
+	if _, err := client.WaitForImageStatus(ctx, image.ID, linodego.ImageStatusAvailable,
+		int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return diag.Errorf("failed to wait for image %s to be available: %v", image.ID, err)
+	}
+
 	return resourceLinodeImageRead(ctx, d, meta)
 }
 
+// resourceLinodeImageCustomizeDiff validates the source disk of an Image taken from a Linode at plan time.
+func resourceLinodeImageCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" || !d.NewValueKnown("linode_id") || !d.NewValueKnown("disk_id") {
+		return nil
+	}
+
+	linodeID := d.Get("linode_id").(int)
+	diskID := d.Get("disk_id").(int)
+	if linodeID == 0 || diskID == 0 {
+		return nil
+	}
+
+	client := meta.(*ProviderMeta).Client
+
+	disk, err := client.GetInstanceDisk(ctx, linodeID, diskID)
+	if err != nil {
+		if isLinodeNotFound(err) {
+			return fmt.Errorf("disk_id %d is not a disk of Linode instance %d", diskID, linodeID)
+		}
+		return fmt.Errorf("Error getting Linode instance %d disk %d: %s", linodeID, diskID, err)
+	}
+	if disk.Filesystem == linodego.FilesystemSwap {
+		return fmt.Errorf("disk_id %d is a swap disk, which can not be taken as an Image", diskID)
+	}
+
+	instance, err := client.GetInstance(ctx, linodeID)
+	if err != nil {
+		return fmt.Errorf("Error getting Linode instance %d: %s", linodeID, err)
+	}
+	if instance.Status == linodego.InstanceRunning {
+		log.Printf("[WARN] Linode instance %d is running; power it off before taking an Image of disk %d "+
+			"so that the Image has a consistent filesystem", linodeID, diskID)
+	}
+
+	return nil
+}
+
 func resourceLinodeImageCreateFromUpload(
 	ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*ProviderMeta).Client
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -79,6 +80,7 @@ func TestAccLinodeImage_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resName, "type", "manual"),
 					resource.TestCheckResourceAttr(resName, "is_public", "false"),
 					resource.TestCheckResourceAttrSet(resName, "deprecated"),
+					resource.TestCheckResourceAttr(resName, "status", "available"),
 				),
 			},
 			{
@@ -183,6 +185,27 @@ func TestAccLinodeImage_uploadFile(t *testing.T) {
 	})
 }
 
+func TestAccLinodeImage_swapDisk(t *testing.T) {
+	t.Parallel()
+
+	var imageName = acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckLinodeImageConfigSwapDiskInstance(imageName),
+			},
+			{
+				Config:      testAccCheckLinodeImageConfigSwapDisk(imageName),
+				ExpectError: regexp.MustCompile("is a swap disk"),
+			},
+		},
+	})
+}
+
 func testAccCheckLinodeImageExists(name string, image *linodego.Image) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client := testAccProvider.Meta().(*ProviderMeta).Client
@@ -297,3 +320,27 @@ resource "linode_image" "foobar" {
 	description = "really descriptive text"
 }`, image, file, file)
 }
+
+func testAccCheckLinodeImageConfigSwapDiskInstance(image string) string {
+	return fmt.Sprintf(`
+	resource "linode_instance" "foobar" {
+		label = "%s"
+		group = "tf_test"
+		type = "g6-standard-1"
+		region = "us-east"
+		disk {
+			label = "swap"
+			size = 512
+			filesystem = "swap"
+		}
+	}`, image)
+}
+
+func testAccCheckLinodeImageConfigSwapDisk(image string) string {
+	return testAccCheckLinodeImageConfigSwapDiskInstance(image) + fmt.Sprintf(`
+	resource "linode_image" "foobar" {
+		linode_id = "${linode_instance.foobar.id}"
+		disk_id = "${linode_instance.foobar.disk.0.id}"
+		label = "%s"
+	}`, image)
+}
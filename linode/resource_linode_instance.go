@@ -75,12 +75,86 @@ func resourceLinodeInstanceConfigInterface() *schema.Resource {
 	}
 }
 
+func resourceLinodeInstanceMaintenance() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type: schema.TypeString,
+				Description: "The kind of maintenance, e.g. maintenance, reboot_scheduled, migration_scheduled, " +
+					"migration_pending, or migration_imminent.",
+				Computed: true,
+			},
+			"severity": {
+				Type:        schema.TypeString,
+				Description: "The severity of the maintenance, either minor, major, or critical.",
+				Computed:    true,
+			},
+			"message": {
+				Type:        schema.TypeString,
+				Description: "A description of the maintenance.",
+				Computed:    true,
+			},
+			"when": {
+				Type:        schema.TypeString,
+				Description: "When the maintenance starts, if it is scheduled.",
+				Computed:    true,
+			},
+			"until": {
+				Type:        schema.TypeString,
+				Description: "When the maintenance is expected to end, if known.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceLinodeInstanceFirewall() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the Firewall created for this Linode.",
+			},
+			"label": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The label for the Firewall. For display purposes only.",
+				ValidateFunc: validation.StringLenBetween(3, 32),
+			},
+			"inbound": {
+				Type:        schema.TypeList,
+				Elem:        resourceLinodeFirewallRule(),
+				Optional:    true,
+				Description: "A firewall rule that specifies what inbound network traffic is allowed.",
+			},
+			"inbound_policy": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The default behavior for inbound traffic.",
+			},
+			"outbound": {
+				Type:        schema.TypeList,
+				Elem:        resourceLinodeFirewallRule(),
+				Optional:    true,
+				Description: "A firewall rule that specifies what outbound network traffic is allowed.",
+			},
+			"outbound_policy": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The default behavior for outbound traffic.",
+			},
+		},
+	}
+}
+
 func resourceLinodeInstance() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceLinodeInstanceCreate,
 		ReadContext:   resourceLinodeInstanceRead,
 		UpdateContext: resourceLinodeInstanceUpdate,
 		DeleteContext: resourceLinodeInstanceDelete,
+		CustomizeDiff: resourceLinodeInstanceCustomizeDiff,
 
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -97,8 +171,14 @@ func resourceLinodeInstance() *schema.Resource {
 					"while your Images start with private/. See /images for more information on the Images available " +
 					"for you to use.",
 				Optional:      true,
-				ForceNew:      true,
-				ConflictsWith: []string{"disk", "config", "backup_id"},
+				ConflictsWith: []string{"disk", "config", "backup_id", "restore_from_backup"},
+			},
+			"allow_image_rebuild": {
+				Type: schema.TypeBool,
+				Description: "If true, changing the image rebuilds the implicit boot disk from the new image instead " +
+					"of creating a new instance. All data on the boot disk is lost.",
+				Optional:      true,
+				ConflictsWith: []string{"disk", "config"},
 			},
 			"backup_id": {
 				Type: schema.TypeInt,
@@ -108,7 +188,32 @@ func resourceLinodeInstance() *schema.Resource {
 					"backups. This field and the image field are mutually exclusive.",
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"image", "disk", "config"},
+				ConflictsWith: []string{"image", "disk", "config", "restore_from_backup"},
+			},
+			"restore_from_backup": {
+				Type: schema.TypeList,
+				Description: "Restore a Backup of another Linode into this Linode once it has been created, then boot " +
+					"it. This block, the image field, and the backup_id field are mutually exclusive.",
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"image", "backup_id", "disk", "config"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"linode_id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the Linode that the Backup belongs to.",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"backup_id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the Backup to restore.",
+							Required:    true,
+							ForceNew:    true,
+						},
+					},
+				},
 			},
 			"stackscript_id": {
 				Type: schema.TypeInt,
@@ -137,9 +242,12 @@ func resourceLinodeInstance() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(3, 50),
 			},
 			"group": {
-				Type:        schema.TypeString,
-				Description: "The display group of the Linode instance.",
-				Optional:    true,
+				Type: schema.TypeString,
+				Description: "The display group of the Linode instance. This is a legacy field that is kept " +
+					"separately from tags.",
+				Optional:   true,
+				Computed:   true,
+				Deprecated: "Display groups are deprecated; use tags to organize Linode instances instead.",
 			},
 			"tags": {
 				Type:        schema.TypeSet,
@@ -172,6 +280,36 @@ func resourceLinodeInstance() *schema.Resource {
 				Description: "The status of the instance, indicating the current readiness state.",
 				Computed:    true,
 			},
+			"desired_state": {
+				Type: schema.TypeString,
+				Description: "The power state the instance is kept in, either running or offline. The instance is " +
+					"booted or shut down whenever its status differs from this state.",
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(linodego.InstanceRunning), string(linodego.InstanceOffline),
+				}, false),
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Description: "When this Linode was created.",
+				Computed:    true,
+			},
+			"updated": {
+				Type:        schema.TypeString,
+				Description: "When this Linode was last updated.",
+				Computed:    true,
+			},
+			"hypervisor": {
+				Type:        schema.TypeString,
+				Description: "The virtualization software powering this Linode.",
+				Computed:    true,
+			},
+			"maintenance": {
+				Type:        schema.TypeList,
+				Description: "The pending host maintenance and migrations of this Linode.",
+				Computed:    true,
+				Elem:        resourceLinodeInstanceMaintenance(),
+			},
 			"ip_address": {
 				Type: schema.TypeString,
 				Description: "This Linode's Public IPv4 Address. If there are multiple public IPv4 addresses on this " +
@@ -193,6 +331,27 @@ func resourceLinodeInstance() *schema.Resource {
 				Computed: true,
 			},
 
+			"firewall_id": {
+				Type:          schema.TypeInt,
+				Description:   "The ID of the Firewall to attach this Linode to.",
+				Optional:      true,
+				ConflictsWith: []string{"firewall"},
+			},
+			"firewall_ids": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The IDs of the Firewalls this Linode is attached to.",
+				Computed:    true,
+			},
+			"firewall": {
+				Type: schema.TypeList,
+				Description: "A dedicated Firewall that is created for and attached to this Linode. " +
+					"The Firewall is deleted along with the Linode.",
+				Optional:      true,
+				MaxItems:      1,
+				Elem:          resourceLinodeInstanceFirewall(),
+				ConflictsWith: []string{"firewall_id"},
+			},
 			"private_ip": {
 				Type: schema.TypeBool,
 				Description: "If true, the created Linode will have private networking enabled, allowing use of the " +
@@ -227,14 +386,28 @@ func resourceLinodeInstance() *schema.Resource {
 				ConflictsWith: []string{"disk", "config"},
 			},
 			"root_pass": {
-				Type:          schema.TypeString,
-				Description:   "The password that will be initialially assigned to the 'root' user account.",
+				Type: schema.TypeString,
+				Description: "The password that will be initialially assigned to the 'root' user account. Changing " +
+					"it forces a new instance unless rotate_root_pass is changed as well.",
 				Sensitive:     true,
 				Optional:      true,
-				ForceNew:      true,
 				StateFunc:     rootPasswordState,
 				ConflictsWith: []string{"disk", "config"},
 			},
+			"rotate_root_pass": {
+				Type: schema.TypeInt,
+				Description: "A counter that, when changed along with root_pass, resets the password of the 'root' " +
+					"user account on the boot disk instead of creating a new instance. The instance is shut down " +
+					"during the reset.",
+				Optional:      true,
+				ConflictsWith: []string{"disk", "config"},
+			},
+			"disk_filesystem_paths": {
+				Type:        schema.TypeMap,
+				Description: "A map of Disk labels to the device paths (e.g. /dev/sda) they are attached at.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+			},
 			"swap_size": {
 				Type: schema.TypeInt,
 				Description: "When deploying from an Image, this field is optional with a Linode API default of " +
@@ -244,6 +417,30 @@ func resourceLinodeInstance() *schema.Resource {
 				Default:       nil,
 				ConflictsWith: []string{"disk", "config"},
 			},
+			"root_disk_size": {
+				Type: schema.TypeInt,
+				Description: "When deploying from an Image, the size in MB of the implicit root disk. The space of the " +
+					"Instance type not used by the root and swap disks is left unallocated.",
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.IntAtLeast(1),
+				RequiredWith:  []string{"image"},
+				ConflictsWith: []string{"disk", "config", "disk_expansion"},
+			},
+			"allow_auto_disk_resize": {
+				Type: schema.TypeBool,
+				Description: "If true, the Linode API resizes the disk of the Instance along with its type, if the " +
+					"Instance has no more than one data disk and one swap disk.",
+				Optional:      true,
+				ConflictsWith: []string{"root_disk_size"},
+			},
+			"disk_expansion": {
+				Type: schema.TypeBool,
+				Description: "If true, the boot disk of an Instance with implicit, default disks is expanded to fill " +
+					"the additional space when the Instance type is upsized.",
+				Optional:      true,
+				ConflictsWith: []string{"disk", "config"},
+			},
 			"backups_enabled": {
 				Type: schema.TypeBool,
 				Description: "If this field is set to true, the created Linode will automatically be enrolled in the " +
@@ -297,6 +494,26 @@ func resourceLinodeInstance() *schema.Resource {
 				},
 			},
 
+			"price": {
+				Type:        schema.TypeList,
+				Description: "The cost of this Linode's type in US dollars, broken down into hourly and monthly charges.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hourly": {
+							Type:        schema.TypeFloat,
+							Description: "Cost (in US dollars) per hour.",
+							Computed:    true,
+						},
+						"monthly": {
+							Type:        schema.TypeFloat,
+							Description: "Cost (in US dollars) per month.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+
 			"alerts": {
 				Computed:    true,
 				Description: "Configuration options for alert triggers on this Linode.",
@@ -450,9 +667,9 @@ func resourceLinodeInstance() *schema.Resource {
 									},
 									"devtmpfs_automount": {
 										Type:        schema.TypeBool,
-										Description: "Populates the /dev directory early during boot without udev. Defaults to false.",
+										Description: "Populates the /dev directory early during boot without udev.",
 										Optional:    true,
-										Default:     false,
+										Default:     true,
 									},
 								},
 							},
@@ -542,6 +759,7 @@ func resourceLinodeInstance() *schema.Resource {
 						"kernel": {
 							Type:     schema.TypeString,
 							Optional: true,
+							Computed: true,
 							Description: "A Kernel ID to boot a Linode with. Default is based on image choice. " +
 								"(examples: linode/latest-64bit, linode/grub2, linode/direct-disk)",
 						},
@@ -572,9 +790,10 @@ func resourceLinodeInstance() *schema.Resource {
 						},
 
 						"memory_limit": {
-							Type:        schema.TypeInt,
-							Optional:    true,
-							Description: "Defaults to the total RAM of the Linode",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "Defaults to the total RAM of the Linode",
+							ValidateFunc: validation.IntAtLeast(0),
 						},
 					},
 				},
@@ -615,6 +834,11 @@ func resourceLinodeInstance() *schema.Resource {
 							Computed:     true,
 							ValidateFunc: validation.StringInSlice([]string{"raw", "swap", "ext3", "ext4", "initrd"}, false),
 						},
+						"filesystem_path": {
+							Type:        schema.TypeString,
+							Description: "The device path (e.g. /dev/sda) the Disk is attached at in the boot Config.",
+							Computed:    true,
+						},
 						"read_only": {
 							Type:        schema.TypeBool,
 							Description: "If true, this Disk is read-only.",
@@ -626,26 +850,20 @@ func resourceLinodeInstance() *schema.Resource {
 							Type: schema.TypeString,
 							Description: "An Image ID to deploy the Disk from. Official Linode Images start with linode/, " +
 								"while your Images start with private/.",
-							Optional: true,
-							Computed: true,
-							ForceNew: true,
-							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								// the API does not return this field for existing disks, so must be ignored for diffs/updates
-								return !d.HasChange("label")
-							},
+							Optional:         true,
+							Computed:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: suppressInstanceDiskWriteOnlyDiff,
 						},
 						"authorized_keys": {
 							Type: schema.TypeList,
 							Elem: &schema.Schema{Type: schema.TypeString},
 							Description: "A list of SSH public keys to deploy for the root user on the newly created Linode. " +
 								"Only accepted if 'image' is provided.",
-							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								// the API does not return this field for existing disks, so must be ignored for diffs/updates
-								return !d.HasChange("label")
-							},
-							Optional:  true,
-							ForceNew:  true,
-							StateFunc: sshKeyState,
+							DiffSuppressFunc: suppressInstanceDiskWriteOnlyDiff,
+							Optional:         true,
+							ForceNew:         true,
+							StateFunc:        sshKeyState,
 						},
 						"authorized_users": {
 							Type: schema.TypeList,
@@ -653,54 +871,42 @@ func resourceLinodeInstance() *schema.Resource {
 							Description: "A list of Linode usernames. If the usernames have associated SSH keys, " +
 								"the keys will be appended to the `root` user's `~/.ssh/authorized_keys` file automatically. " +
 								"Only accepted if 'image' is provided.",
-							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								// the API does not return this field for existing disks, so must be ignored for diffs/updates
-								return !d.HasChange("label")
-							},
-							Optional:  true,
-							ForceNew:  true,
-							StateFunc: sshKeyState,
+							DiffSuppressFunc: suppressInstanceDiskWriteOnlyDiff,
+							Optional:         true,
+							ForceNew:         true,
+							StateFunc:        sshKeyState,
 						},
 						"stackscript_id": {
 							Type: schema.TypeInt,
 							Description: "The StackScript to deploy to the newly created Linode. If provided, 'image' " +
 								"must also be provided, and must be an Image that is compatible with this StackScript.",
-							Computed: true,
-							Optional: true,
-							ForceNew: true,
-							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								// the API does not return this field for existing disks, so must be ignored for diffs/updates
-								return !d.HasChange("label")
-							},
-							Default: nil,
+							Computed:         true,
+							Optional:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: suppressInstanceDiskWriteOnlyDiff,
+							Default:          nil,
 						},
 						"stackscript_data": {
 							Type: schema.TypeMap,
 							Description: "An object containing responses to any User Defined Fields present in the " +
 								"StackScript being deployed to this Linode. Only accepted if 'stackscript_id' is given. " +
 								"The required values depend on the StackScript being deployed.",
-							Optional:  true,
-							Computed:  true,
-							ForceNew:  true,
-							Sensitive: true,
-							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								// the API does not return this field for existing disks, so must be ignored for diffs/updates
-								return !d.HasChange("label")
-							},
-							Default: nil,
+							Optional:         true,
+							Computed:         true,
+							ForceNew:         true,
+							Sensitive:        true,
+							DiffSuppressFunc: suppressInstanceDiskWriteOnlyDiff,
+							Default:          nil,
 						},
 						"root_pass": {
-							Type:        schema.TypeString,
-							Description: "The password that will be initialially assigned to the 'root' user account.",
-							Sensitive:   true,
-							Optional:    true,
-							ForceNew:    true,
-							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								// the API does not return this field for existing disks, so must be ignored for diffs/updates
-								return !d.HasChange("label")
-							},
-							ValidateFunc: validation.StringLenBetween(6, 128),
-							StateFunc:    rootPasswordState,
+							Type:             schema.TypeString,
+							Description:      "The password that will be initialially assigned to the 'root' user account.",
+							Sensitive:        true,
+							Optional:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: suppressInstanceDiskWriteOnlyDiff,
+							ValidateFunc:     validation.StringLenBetween(6, 128),
+							StateFunc:        rootPasswordState,
 						},
 					},
 				},
@@ -716,9 +922,13 @@ func resourceLinodeInstanceRead(ctx context.Context, d *schema.ResourceData, met
 		return diag.Errorf("Error parsing Linode instance ID %s as int: %s", d.Id(), err)
 	}
 
-	instance, err := client.GetInstance(ctx, int(id))
+	var instance *linodego.Instance
+	err = retryOnNotFoundIfNew(ctx, d, func(ctx context.Context) (err error) {
+		instance, err = client.GetInstance(ctx, int(id))
+		return err
+	})
 	if err != nil {
-		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+		if isLinodeNotFound(err) && !d.IsNewResource() {
 			log.Printf("[WARN] removing Linode Instance ID %q from state because it no longer exists", d.Id())
 			d.SetId("")
 			return nil
@@ -758,12 +968,57 @@ func resourceLinodeInstanceRead(ctx context.Context, d *schema.ResourceData, met
 
 	d.Set("label", instance.Label)
 	d.Set("status", instance.Status)
+	d.Set("hypervisor", instance.Hypervisor)
+
+	maintenance, err := listInstanceMaintenance(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("maintenance", maintenance[instance.ID])
+
+	if instance.Created != nil {
+		d.Set("created", instance.Created.Format(time.RFC3339))
+	}
+	if instance.Updated != nil {
+		d.Set("updated", instance.Updated.Format(time.RFC3339))
+	}
 	d.Set("type", instance.Type)
 	d.Set("region", instance.Region)
 	d.Set("watchdog_enabled", instance.WatchdogEnabled)
 	d.Set("group", instance.Group)
 	d.Set("tags", instance.Tags)
 
+	if firewallID := d.Get("firewall_id").(int); firewallID != 0 {
+		device, err := getInstanceFirewallDevice(ctx, client, firewallID, instance.ID)
+		if err != nil && !isLinodeNotFound(err) {
+			return diag.Errorf("Error getting the Firewall %d devices for Linode instance %d: %s", firewallID, id, err)
+		}
+		if device == nil {
+			log.Printf("[WARN] Linode instance %d is no longer attached to Firewall %d", id, firewallID)
+			d.Set("firewall_id", 0)
+		}
+	}
+
+	firewallIDs, err := getInstanceFirewallIDs(ctx, client, instance.ID)
+	if err != nil {
+		return diag.Errorf("Error getting the Firewalls for Linode instance %d: %s", id, err)
+	}
+	d.Set("firewall_ids", firewallIDs)
+
+	if firewalls := d.Get("firewall").([]interface{}); len(firewalls) > 0 && firewalls[0] != nil {
+		declared := firewalls[0].(map[string]interface{})
+		firewall, err := flattenInstanceFirewall(ctx, client, declared["id"].(int), declared)
+		if err != nil && !isLinodeNotFound(err) {
+			return diag.Errorf("Error getting the Firewall for Linode instance %d: %s", id, err)
+		}
+		if firewall == nil {
+			log.Printf("[WARN] Firewall for Linode instance %d no longer exists", id)
+			d.Set("firewall", nil)
+		} else {
+			d.Set("firewall", []map[string]interface{}{firewall})
+		}
+	}
+
 	flatSpecs := flattenInstanceSpecs(*instance)
 	flatAlerts := flattenInstanceAlerts(*instance)
 	flatBackups := flattenInstanceBackups(*instance)
@@ -772,19 +1027,30 @@ func resourceLinodeInstanceRead(ctx context.Context, d *schema.ResourceData, met
 	d.Set("specs", flatSpecs)
 	d.Set("alerts", flatAlerts)
 
+	instanceType, err := client.GetType(ctx, instance.Type)
+	if err != nil {
+		return diag.Errorf("Error getting the type %s of Linode instance %d: %s", instance.Type, id, err)
+	}
+	d.Set("price", flattenInstancePrice(instanceType))
+
 	instanceDisks, err := client.ListInstanceDisks(ctx, int(id), nil)
 	if err != nil {
 		return diag.Errorf("Error getting the disks for the Linode instance %d: %s", id, err)
 	}
 
-	disks, swapSize := flattenInstanceDisks(instanceDisks)
-	d.Set("disk", disks)
-	d.Set("swap_size", swapSize)
-
 	instanceConfigs, err := client.ListInstanceConfigs(ctx, int(id), nil)
 	if err != nil {
 		return diag.Errorf("Error getting the config for Linode instance %d (%s): %s", instance.ID, instance.Label, err)
 	}
+
+	filesystemPaths := getInstanceDiskFilesystemPaths(instanceConfigs, d.Get("boot_config_label").(string))
+	disks, swapSize := flattenInstanceDisks(instanceDisks, filesystemPaths)
+
+	carryOverInstanceDiskWriteOnlyFields(disks, d.Get("disk").([]interface{}))
+
+	d.Set("disk", disks)
+	d.Set("disk_filesystem_paths", flattenInstanceDiskFilesystemPaths(instanceDisks, filesystemPaths))
+	d.Set("swap_size", swapSize)
 	diskLabelIDMap := make(map[int]string, len(instanceDisks))
 	for _, disk := range instanceDisks {
 		diskLabelIDMap[disk.ID] = disk.Label
@@ -843,9 +1109,12 @@ func resourceLinodeInstanceCreate(ctx context.Context, d *schema.ResourceData, m
 
 	_, disksOk := d.GetOk("disk")
 	_, configsOk := d.GetOk("config")
+	_, restoreOk := d.GetOk("restore_from_backup.0")
 
 	// If we don't have disks and we don't have configs, use the single API call approach
-	if !disksOk && !configsOk {
+	if restoreOk {
+		createOpts.Booted = &boolFalse // the backup is restored into the Linode and booted after it is created
+	} else if !disksOk && !configsOk {
 		for _, key := range d.Get("authorized_keys").([]interface{}) {
 			createOpts.AuthorizedKeys = append(createOpts.AuthorizedKeys, key.(string))
 		}
@@ -862,6 +1131,9 @@ func resourceLinodeInstanceCreate(ctx context.Context, d *schema.ResourceData, m
 		}
 		createOpts.Image = d.Get("image").(string)
 		createOpts.Booted = &boolTrue
+		if d.Get("root_disk_size").(int) > 0 {
+			createOpts.Booted = &boolFalse // the root disk is resized before the first boot
+		}
 		createOpts.BackupID = d.Get("backup_id").(int)
 		if swapSize := d.Get("swap_size").(int); swapSize > 0 {
 			createOpts.SwapSize = &swapSize
@@ -885,7 +1157,7 @@ func resourceLinodeInstanceCreate(ctx context.Context, d *schema.ResourceData, m
 
 	instance, err := client.CreateInstance(ctx, createOpts)
 	if err != nil {
-		return diag.Errorf("Error creating a Linode Instance: %s", err)
+		return diag.Errorf("Error creating a Linode Instance: %s", formatLinodeError(err))
 	}
 
 	d.SetId(fmt.Sprintf("%d", instance.ID))
@@ -917,14 +1189,7 @@ func resourceLinodeInstanceCreate(ctx context.Context, d *schema.ResourceData, m
 
 	if _, alertsOk := d.GetOk("alerts.0"); alertsOk {
 		doUpdate = true
-		updateOpts.Alerts = &linodego.InstanceAlert{}
-
-		// TODO(displague) only set specified alerts
-		updateOpts.Alerts.CPU = d.Get("alerts.0.cpu").(int)
-		updateOpts.Alerts.IO = d.Get("alerts.0.io").(int)
-		updateOpts.Alerts.NetworkIn = d.Get("alerts.0.network_in").(int)
-		updateOpts.Alerts.NetworkOut = d.Get("alerts.0.network_out").(int)
-		updateOpts.Alerts.TransferQuota = d.Get("alerts.0.transfer_quota").(int)
+		updateOpts.Alerts = expandInstanceAlerts(d, instance.Alerts)
 	}
 
 	if doUpdate {
@@ -934,6 +1199,57 @@ func resourceLinodeInstanceCreate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	if firewallID, ok := d.GetOk("firewall_id"); ok {
+		if err := attachInstanceFirewall(ctx, client, firewallID.(int), instance.ID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if firewalls := d.Get("firewall").([]interface{}); len(firewalls) > 0 && firewalls[0] != nil {
+		spec := firewalls[0].(map[string]interface{})
+		firewallID, err := createInstanceFirewall(ctx, client, instance.ID, spec)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		spec["id"] = firewallID
+		d.Set("firewall", []map[string]interface{}{spec})
+	}
+
+	if restoreOk {
+		if err := restoreInstanceBackup(ctx, client, *instance, d); err != nil {
+			return diag.FromErr(err)
+		}
+
+		// the restored configs are only known now, so boot into the default one
+		if err = client.BootInstance(ctx, instance.ID, 0); err != nil {
+			return diag.Errorf("Error booting Linode instance %d: %s", instance.ID, err)
+		}
+
+		if _, err = client.WaitForEventFinished(
+			ctx, instance.ID, linodego.EntityLinode, linodego.ActionLinodeBoot,
+			*instance.Created, getDeadlineSeconds(ctx, d),
+		); err != nil {
+			return diag.Errorf("Error booting Linode instance %d: %s", instance.ID, err)
+		}
+	}
+
+	if rootDiskSize := d.Get("root_disk_size").(int); rootDiskSize > 0 {
+		if err := resizeInstanceRootDisk(ctx, client, *instance, rootDiskSize, d); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err = client.BootInstance(ctx, instance.ID, 0); err != nil {
+			return diag.Errorf("Error booting Linode instance %d: %s", instance.ID, err)
+		}
+
+		if _, err = client.WaitForEventFinished(
+			ctx, instance.ID, linodego.EntityLinode, linodego.ActionLinodeBoot,
+			*instance.Created, getDeadlineSeconds(ctx, d),
+		); err != nil {
+			return diag.Errorf("Error booting Linode instance %d: %s", instance.ID, err)
+		}
+	}
+
 	// Look up tables for any disks and configs we create
 	// - so configs and initrd can reference disks by label
 	// - so configs can be referenced as a boot_config_label param
@@ -947,17 +1263,9 @@ func resourceLinodeInstanceCreate(ctx context.Context, d *schema.ResourceData, m
 			return diag.Errorf("Error waiting for Instance to finish creating: %s", err)
 		}
 
-		diskSpecs := d.Get("disk").([]interface{})
-		diskIDLabelMap = make(map[string]int, len(diskSpecs))
-
-		for _, diskSpec := range diskSpecs {
-			diskSpec := diskSpec.(map[string]interface{})
-
-			instanceDisk, err := createInstanceDisk(ctx, client, *instance, diskSpec, d)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-			diskIDLabelMap[instanceDisk.Label] = instanceDisk.ID
+		diskIDLabelMap, err = createInstanceDisks(ctx, client, *instance, d.Get("disk").([]interface{}), d)
+		if err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
@@ -994,13 +1302,13 @@ func resourceLinodeInstanceCreate(ctx context.Context, d *schema.ResourceData, m
 			); err != nil {
 				return diag.Errorf("Error booting Linode instance %d: %s", instance.ID, err)
 			}
-		} else {
+		} else if !restoreOk && d.Get("root_disk_size").(int) == 0 {
 			targetStatus = linodego.InstanceOffline
 		}
 	}
 
 	// If the instance has implicit disks and config with no specified image it will not boot.
-	if !(disksOk && configsOk) && len(instance.Image) < 1 {
+	if !(disksOk && configsOk) && !restoreOk && len(instance.Image) < 1 {
 		targetStatus = linodego.InstanceOffline
 	}
 
@@ -1010,6 +1318,10 @@ func resourceLinodeInstanceCreate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	if err := applyInstanceDesiredState(ctx, client, instance.ID, bootConfig, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceLinodeInstanceRead(ctx, d, meta)
 }
 
@@ -1044,6 +1356,14 @@ func adjustSwapSizeIfNeeded(
 	diff := newSwap - oldSwap
 	newBootDiskSize := bootDisk.Size - diff
 
+	// a root disk capped by root_disk_size keeps its size, the swap disk uses the unallocated space instead
+	if d.Get("root_disk_size").(int) > 0 {
+		if err := changeInstanceDiskSize(ctx, client, *instance, *swapDisk, newSwap, d); err != nil {
+			return true, err
+		}
+		return true, nil
+	}
+
 	toResize := []struct {
 		size int
 		disk *linodego.InstanceDisk
@@ -1091,16 +1411,23 @@ func resourceLinodeInstanceUpdate(ctx context.Context, d *schema.ResourceData, m
 		simpleUpdate = true
 	}
 	if d.HasChange("group") {
-		updateOpts.Group = d.Get("group").(string)
+		// group is computed, so it is only empty here if it is explicitly set to an empty string;
+		// the API client omits an empty group, so a group can only be replaced, not removed
+		group := d.Get("group").(string)
+		if group == "" {
+			return diag.Errorf("Error removing the display group of Instance %d: a group can not be removed "+
+				"once set; use tags to organize the instance instead", instance.ID)
+		}
+		updateOpts.Group = group
 		simpleUpdate = true
 	}
 	if d.HasChange("tags") {
-		var tags []string
-		for _, tag := range d.Get("tags").(*schema.Set).List() {
-			tags = append(tags, tag.(string))
+		oldTags, newTags := d.GetChange("tags")
+		err := updateResourceTags(ctx, client, linodego.EntityLinode, instance.ID,
+			expandStringSet(oldTags.(*schema.Set)), expandStringSet(newTags.(*schema.Set)))
+		if err != nil {
+			return diag.FromErr(err)
 		}
-		updateOpts.Tags = &tags
-		simpleUpdate = true
 	}
 	if d.HasChange("watchdog_enabled") {
 		watchdogEnabled := d.Get("watchdog_enabled").(bool)
@@ -1108,12 +1435,7 @@ func resourceLinodeInstanceUpdate(ctx context.Context, d *schema.ResourceData, m
 		simpleUpdate = true
 	}
 	if d.HasChange("alerts") {
-		updateOpts.Alerts = &linodego.InstanceAlert{}
-		updateOpts.Alerts.CPU = d.Get("alerts.0.cpu").(int)
-		updateOpts.Alerts.IO = d.Get("alerts.0.io").(int)
-		updateOpts.Alerts.NetworkIn = d.Get("alerts.0.network_in").(int)
-		updateOpts.Alerts.NetworkOut = d.Get("alerts.0.network_out").(int)
-		updateOpts.Alerts.TransferQuota = d.Get("alerts.0.transfer_quota").(int)
+		updateOpts.Alerts = expandInstanceAlerts(d, instance.Alerts)
 		simpleUpdate = true
 	}
 
@@ -1122,7 +1444,7 @@ func resourceLinodeInstanceUpdate(ctx context.Context, d *schema.ResourceData, m
 		instanceID := instance.ID
 
 		if instance, err = client.UpdateInstance(ctx, instance.ID, updateOpts); err != nil {
-			return diag.Errorf("Error updating Instance %d: %s", instanceID, err)
+			return diag.Errorf("Error updating Instance %d: %s", instanceID, formatLinodeError(err))
 		}
 	}
 
@@ -1139,18 +1461,37 @@ func resourceLinodeInstanceUpdate(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	rebootInstance := false
+	rebootForInterfaces := false
 
-	if d.HasChange("private_ip") {
-		if _, ok := d.GetOk("private_ip"); !ok {
-			return diag.Errorf("Error removing private IP address for Instance %d: Removing a Private IP "+
-				"address must be handled through a support ticket", instance.ID)
+	if d.HasChange("firewall_id") {
+		oldFirewallID, newFirewallID := d.GetChange("firewall_id")
+		if err := detachInstanceFirewall(ctx, client, oldFirewallID.(int), instance.ID); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := attachInstanceFirewall(ctx, client, newFirewallID.(int), instance.ID); err != nil {
+			return diag.FromErr(err)
 		}
+	}
 
-		privateIP, err := client.AddInstanceIPAddress(ctx, instance.ID, false)
-		if err != nil {
-			return diag.Errorf("Error activating private networking on Instance %d: %s", instance.ID, err)
+	if d.HasChange("firewall") {
+		if err := updateInstanceFirewall(ctx, client, d, instance.ID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("private_ip") {
+		if d.Get("private_ip").(bool) {
+			privateIP, err := client.AddInstanceIPAddress(ctx, instance.ID, false)
+			if err != nil {
+				return diag.Errorf("Error activating private networking on Instance %d: %s", instance.ID, err)
+			}
+			d.Set("private_ip_address", privateIP.Address)
+		} else {
+			if err := removeInstancePrivateIPs(ctx, client, instance.ID); err != nil {
+				return diag.FromErr(err)
+			}
+			d.Set("private_ip_address", "")
 		}
-		d.Set("private_ip_address", privateIP.Address)
 		rebootInstance = true
 	}
 
@@ -1166,6 +1507,12 @@ func resourceLinodeInstanceUpdate(ctx context.Context, d *schema.ResourceData, m
 			return diag.Errorf("failed to change instance type: %s", err)
 		}
 		rebootInstance = true
+
+		if d.Get("disk_expansion").(bool) {
+			if err := expandInstanceBiggestDisk(ctx, d, &client, instance, newSpec); err != nil {
+				return diag.Errorf("failed to expand instance disk: %s", err)
+			}
+		}
 	}
 
 	if didChange, err := applyInstanceDiskSpec(ctx, d, &client, instance, newSpec); err == nil && didChange {
@@ -1216,6 +1563,19 @@ func resourceLinodeInstanceUpdate(ctx context.Context, d *schema.ResourceData, m
 		bootConfig = updatedConfigs[0].ID
 	}
 
+	// Only interface changes on the config the Linode is running with need a reboot to apply
+	if d.HasChange("config") && bootConfig > 0 && instance.Status == linodego.InstanceRunning {
+		for label, configID := range updatedConfigMap {
+			if configID != bootConfig {
+				continue
+			}
+
+			oldInterfaces, oldFound := expandInstanceConfigSpecInterfaces(tfConfigsOld.([]interface{}), label)
+			newInterfaces, _ := expandInstanceConfigSpecInterfaces(tfConfigsNew.([]interface{}), label)
+			rebootForInterfaces = oldFound && instanceConfigInterfacesNeedReboot(oldInterfaces, newInterfaces)
+		}
+	}
+
 	if d.HasChange("interface") {
 		interfaces := d.Get("interface").([]interface{})
 
@@ -1225,14 +1585,35 @@ func resourceLinodeInstanceUpdate(ctx context.Context, d *schema.ResourceData, m
 			expandedInterfaces[i] = expandLinodeConfigInterface(ni.(map[string]interface{}))
 		}
 
+		// Instances with top-level interfaces use the implicit config created with the instance
+		if bootConfig == 0 {
+			configs, err := client.ListInstanceConfigs(ctx, instance.ID, nil)
+			if err != nil {
+				return diag.Errorf("Error fetching the configs for Instance %d: %s", instance.ID, err)
+			}
+			if len(configs) < 1 {
+				return diag.Errorf("failed to set boot config interfaces: Instance %d has no configs", instance.ID)
+			}
+			bootConfig = configs[0].ID
+		}
+
+		currentConfig, err := client.GetInstanceConfig(ctx, instance.ID, bootConfig)
+		if err != nil {
+			return diag.Errorf("Error fetching Instance %d Config %d: %s", instance.ID, bootConfig, err)
+		}
+
 		if _, err := client.UpdateInstanceConfig(ctx, instance.ID, bootConfig, linodego.InstanceConfigUpdateOptions{
 			Interfaces: expandedInterfaces,
 		}); err != nil {
-			return diag.Errorf("failed to set boot config interfaces: %s", err)
+			return diag.Errorf("failed to set boot config interfaces: %s", formatLinodeError(err))
 		}
+
+		rebootForInterfaces = instance.Status == linodego.InstanceRunning &&
+			instanceConfigInterfacesNeedReboot(currentConfig.Interfaces, expandedInterfaces)
 	}
 
-	if rebootInstance && len(diskIDLabelMap) > 0 && len(updatedConfigMap) > 0 && bootConfig > 0 {
+	if bootConfig > 0 && (rebootForInterfaces ||
+		rebootInstance && len(diskIDLabelMap) > 0 && len(updatedConfigMap) > 0) {
 		err = client.RebootInstance(ctx, instance.ID, bootConfig)
 
 		if err != nil {
@@ -1250,6 +1631,20 @@ func resourceLinodeInstanceUpdate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	if d.HasChange("image") {
+		if err := rebuildInstanceBootDisk(ctx, &client, instance.ID, d); err != nil {
+			return diag.FromErr(err)
+		}
+	} else if d.HasChange("root_pass") && d.HasChange("rotate_root_pass") {
+		if err := rotateInstanceRootPass(ctx, &client, instance.ID, d.Get("root_pass").(string), d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := applyInstanceDesiredState(ctx, client, instance.ID, bootConfig, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceLinodeInstanceRead(ctx, d, meta)
 }
 
@@ -1268,6 +1663,53 @@ func resourceLinodeInstanceDelete(ctx context.Context, d *schema.ResourceData, m
 	client.WaitForEventFinished(ctx, int(id), linodego.EntityLinode, linodego.ActionLinodeDelete,
 		minDelete, getDeadlineSeconds(ctx, d))
 
+	if firewalls := d.Get("firewall").([]interface{}); len(firewalls) > 0 && firewalls[0] != nil {
+		firewallID := firewalls[0].(map[string]interface{})["id"].(int)
+		if err := client.DeleteFirewall(ctx, firewallID); err != nil && !isLinodeNotFound(err) {
+			return diag.Errorf("Error deleting Firewall %d of Linode instance %d: %s", firewallID, id, err)
+		}
+	}
+
 	d.SetId("")
 	return nil
 }
+
+func resourceLinodeInstanceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	warnInstanceWithoutDisks(d)
+
+	if err := diffInstanceDesiredState(d); err != nil {
+		return err
+	}
+	if err := diffInstanceImage(d); err != nil {
+		return err
+	}
+	if err := diffInstanceRootPass(d); err != nil {
+		return err
+	}
+
+	if err := validateInstanceReadOnlyRootDevice(d); err != nil {
+		return err
+	}
+	if err := validateInstanceConfigRootDevices(d); err != nil {
+		return err
+	}
+	if err := validateInstanceBootConfigLabel(d); err != nil {
+		return err
+	}
+	if err := validateLinodeFirewallRules(d, "firewall.0."); err != nil {
+		return err
+	}
+	if err := validateInstanceConfigMemoryLimits(ctx, d, meta); err != nil {
+		return err
+	}
+	if err := validateInstanceImages(ctx, d, meta); err != nil {
+		return err
+	}
+	if err := validateInstanceConfigKernels(ctx, d, meta); err != nil {
+		return err
+	}
+	if err := validateInstanceRootDiskSize(ctx, d, meta); err != nil {
+		return err
+	}
+	return validateInstanceTypeChange(ctx, d, meta)
+}
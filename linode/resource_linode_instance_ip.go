@@ -2,6 +2,8 @@ package linode
 
 import (
 	"context"
+	"encoding/json"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -139,8 +141,67 @@ func resourceLinodeInstanceIPDelete(ctx context.Context, d *schema.ResourceData,
 
 	address := d.Id()
 	linodeID := d.Get("linode_id").(int)
+
+	// only public addresses can be shared, so private ones skip the region-wide lookup
+	if d.Get("public").(bool) {
+		sharedWith, err := getInstanceIPSharedLinodes(ctx, client, d.Get("region").(string), linodeID, address)
+		if err != nil {
+			return diag.Errorf("failed to check sharing for instance (%d) ip (%s): %s", linodeID, address, err)
+		}
+		if len(sharedWith) > 0 {
+			return diag.Errorf("instance (%d) ip (%s) is shared with linode(s) %v; "+
+				"remove the IP share before deleting this address", linodeID, address, sharedWith)
+		}
+	}
+
 	if err := client.DeleteInstanceIPAddress(ctx, linodeID, address); err != nil {
 		return diag.Errorf("failed to delete instance (%d) ip (%s): %s", linodeID, address, err)
 	}
 	return nil
 }
+
+// getInstanceIPSharedLinodes returns the IDs of the Linodes in the given region
+// that the address is currently shared with.
+func getInstanceIPSharedLinodes(
+	ctx context.Context, client linodego.Client, region string, linodeID int, address string) ([]int, error) {
+	filter, _ := json.Marshal(map[string]interface{}{"region": region})
+
+	instances, err := client.ListInstances(ctx, linodego.NewListOptions(0, string(filter)))
+	if err != nil {
+		return nil, err
+	}
+
+	ipsByLinode := make(map[int]*linodego.InstanceIPAddressResponse, len(instances))
+	for _, instance := range instances {
+		if instance.ID == linodeID {
+			continue
+		}
+
+		ips, err := client.GetInstanceIPAddresses(ctx, instance.ID)
+		if err != nil {
+			return nil, err
+		}
+		ipsByLinode[instance.ID] = ips
+	}
+	return findInstanceIPShares(address, linodeID, ipsByLinode), nil
+}
+
+// findInstanceIPShares returns the sorted IDs of the Linodes, other than the owner,
+// whose shared IPv4 addresses include the given address.
+func findInstanceIPShares(address string, linodeID int, ipsByLinode map[int]*linodego.InstanceIPAddressResponse) []int {
+	var sharedWith []int
+	for id, ips := range ipsByLinode {
+		if id == linodeID || ips == nil || ips.IPv4 == nil {
+			continue
+		}
+
+		for _, ip := range ips.IPv4.Shared {
+			if ip != nil && ip.Address == address {
+				sharedWith = append(sharedWith, id)
+				break
+			}
+		}
+	}
+	sort.Ints(sharedWith)
+	return sharedWith
+}
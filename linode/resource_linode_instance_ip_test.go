@@ -2,10 +2,12 @@ package linode
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/linode/linodego"
 )
 
 const testInstanceIPResName = "linode_instance_ip.test"
@@ -61,3 +63,39 @@ resource "linode_instance_ip" "test" {
 	public = true
 }`, label)
 }
+
+func TestFindInstanceIPShares(t *testing.T) {
+	shared := func(addresses ...string) *linodego.InstanceIPAddressResponse {
+		ips := &linodego.InstanceIPAddressResponse{IPv4: &linodego.InstanceIPv4Response{}}
+		for _, address := range addresses {
+			ips.IPv4.Shared = append(ips.IPv4.Shared, &linodego.InstanceIP{Address: address})
+		}
+		return ips
+	}
+
+	ipsByLinode := map[int]*linodego.InstanceIPAddressResponse{
+		1: shared("192.0.2.10"),
+		2: shared("192.0.2.20", "192.0.2.10"),
+		3: shared(),
+		4: {},
+		5: nil,
+		6: shared("192.0.2.10"),
+	}
+
+	cases := []struct {
+		address  string
+		linodeID int
+		expected []int
+	}{
+		{"192.0.2.10", 1, []int{2, 6}},
+		{"192.0.2.10", 9, []int{1, 2, 6}},
+		{"192.0.2.20", 2, nil},
+		{"192.0.2.30", 1, nil},
+	}
+
+	for _, c := range cases {
+		if result := findInstanceIPShares(c.address, c.linodeID, ipsByLinode); !reflect.DeepEqual(result, c.expected) {
+			t.Errorf("address %s owned by %d: expected %v, got %v", c.address, c.linodeID, c.expected, result)
+		}
+	}
+}
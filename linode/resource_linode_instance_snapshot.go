@@ -0,0 +1,166 @@
+package linode
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/linode/linodego"
+)
+
+const (
+	LinodeInstanceSnapshotCreateTimeout = 30 * time.Minute
+)
+
+func resourceLinodeInstanceSnapshot() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLinodeInstanceSnapshotCreate,
+		ReadContext:   resourceLinodeInstanceSnapshotRead,
+		DeleteContext: resourceLinodeInstanceSnapshotDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(LinodeInstanceSnapshotCreateTimeout),
+		},
+		Schema: map[string]*schema.Schema{
+			"linode_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the Linode to take a snapshot of. The Linode must have backups enabled.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"label": {
+				Type:        schema.TypeString,
+				Description: "The label of the snapshot.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"backup_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the Backup created by the snapshot.",
+				Computed:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The current state of the snapshot.",
+				Computed:    true,
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Description: "The date the snapshot was taken.",
+				Computed:    true,
+			},
+			"finished": {
+				Type:        schema.TypeString,
+				Description: "The date the snapshot finished.",
+				Computed:    true,
+			},
+			"superseded": {
+				Type:        schema.TypeBool,
+				Description: "Whether a newer manual snapshot of the Linode has replaced this one.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceLinodeInstanceSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf("failed to parse Instance Snapshot %s as int: %s", d.Id(), err)
+	}
+
+	linodeID := d.Get("linode_id").(int)
+	snapshot, err := client.GetInstanceSnapshot(ctx, linodeID, id)
+	if err != nil {
+		if isLinodeNotFound(err) {
+			superseded, err := isInstanceSnapshotSuperseded(ctx, client, linodeID, id)
+			if err != nil {
+				return diag.Errorf("failed to get Linode Instance (%d) Backups: %s", linodeID, err)
+			}
+
+			// keep a replaced snapshot in state so that it is not taken again, racing the newer one
+			if superseded {
+				log.Printf("[WARN] Linode Instance (%d) Snapshot %q has been replaced by a newer snapshot",
+					linodeID, d.Id())
+				d.Set("superseded", true)
+				return nil
+			}
+
+			log.Printf("[WARN] removing Linode Instance (%d) Snapshot %q from state because it no longer exists",
+				linodeID, d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("failed to get Linode Instance (%d) Snapshot %d: %s", linodeID, id, err)
+	}
+
+	d.Set("backup_id", snapshot.ID)
+	d.Set("label", snapshot.Label)
+	d.Set("status", snapshot.Status)
+	d.Set("superseded", false)
+
+	if snapshot.Created != nil {
+		d.Set("created", snapshot.Created.Format(time.RFC3339))
+	}
+
+	if snapshot.Finished != nil {
+		d.Set("finished", snapshot.Finished.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceLinodeInstanceSnapshotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+
+	linodeID := d.Get("linode_id").(int)
+	snapshot, err := client.CreateInstanceSnapshot(ctx, linodeID, d.Get("label").(string))
+	if err != nil {
+		return diag.Errorf("failed to create Linode Instance (%d) Snapshot: %s", linodeID, formatLinodeError(err))
+	}
+
+	d.SetId(strconv.Itoa(snapshot.ID))
+
+	if _, err := client.WaitForSnapshotStatus(
+		ctx, linodeID, snapshot.ID, linodego.SnapshotSuccessful, int(d.Timeout(schema.TimeoutCreate).Seconds()),
+	); err != nil {
+		return diag.Errorf("failed to wait for Linode Instance (%d) Snapshot %d to complete: %s",
+			linodeID, snapshot.ID, err)
+	}
+
+	return resourceLinodeInstanceSnapshotRead(ctx, d, meta)
+}
+
+// resourceLinodeInstanceSnapshotDelete only removes the snapshot from state. The Linode API does not allow
+// manual snapshots to be deleted; each new snapshot replaces the previous one.
+func resourceLinodeInstanceSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+// isInstanceSnapshotSuperseded returns true if the Linode has a manual snapshot that is newer than
+// the given one, which means the given snapshot was replaced rather than removed.
+func isInstanceSnapshotSuperseded(ctx context.Context, client linodego.Client, linodeID, snapshotID int) (bool, error) {
+	backups, err := client.GetInstanceBackups(ctx, linodeID)
+	if err != nil {
+		if isLinodeNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if backups.Snapshot == nil {
+		return false, nil
+	}
+
+	for _, snapshot := range []*linodego.InstanceSnapshot{backups.Snapshot.Current, backups.Snapshot.InProgress} {
+		if snapshot != nil && snapshot.ID > snapshotID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,53 @@
+package linode
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const testInstanceSnapshotResName = "linode_instance_snapshot.test"
+
+func TestAccLinodeInstanceSnapshot_basic(t *testing.T) {
+	t.Parallel()
+
+	instanceName := acctest.RandomWithPrefix("tf_test")
+	snapshotName := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckLinodeInstanceSnapshotBasic(instanceName, snapshotName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(testInstanceSnapshotResName, "linode_id", "linode_instance.foobar", "id"),
+					resource.TestCheckResourceAttr(testInstanceSnapshotResName, "label", snapshotName),
+					resource.TestCheckResourceAttr(testInstanceSnapshotResName, "status", "successful"),
+					resource.TestCheckResourceAttrSet(testInstanceSnapshotResName, "backup_id"),
+					resource.TestCheckResourceAttrSet(testInstanceSnapshotResName, "created"),
+					resource.TestCheckResourceAttrSet(testInstanceSnapshotResName, "finished"),
+					resource.TestCheckResourceAttr(testInstanceSnapshotResName, "superseded", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLinodeInstanceSnapshotBasic(instanceName, snapshotName string) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label           = "%s"
+	type            = "g6-nanode-1"
+	region          = "us-east"
+	image           = "linode/alpine3.12"
+	backups_enabled = true
+}
+
+resource "linode_instance_snapshot" "test" {
+	linode_id = linode_instance.foobar.id
+	label     = "%s"
+}`, instanceName, snapshotName)
+}
@@ -83,6 +83,30 @@ func TestAccLinodeInstance_basic(t *testing.T) {
 	})
 }
 
+func TestAccLinodeInstance_removeGroup(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_instance.foobar"
+	instanceName := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckLinodeInstanceBasic(instanceName, publicKeyMaterial),
+				Check:  resource.TestCheckResourceAttr(resName, "group", "tf_test"),
+			},
+			{
+				// removing the deprecated group from the configuration keeps the existing group
+				Config: testAccCheckLinodeInstanceNoGroup(instanceName, publicKeyMaterial),
+				Check:  resource.TestCheckResourceAttr(resName, "group", "tf_test"),
+			},
+		},
+	})
+}
+
 func TestAccLinodeInstance_dontPoll(t *testing.T) {
 	t.Parallel()
 
@@ -329,6 +353,11 @@ func TestAccLinodeInstance_configInterfaces(t *testing.T) {
 					resource.TestCheckResourceAttr(resName, "config.0.interface.1.label", "tf-really-cool-vlan"),
 				),
 			},
+			{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccCheckLinodeInstanceWithConfigInterfacesUpdateEmpty(instanceName),
 				Check: resource.ComposeTestCheckFunc(
@@ -712,6 +741,7 @@ func TestAccLinodeInstance_configUpdate(t *testing.T) {
 					resource.TestCheckResourceAttr(resName, "config.0.root_device", "/dev/sda"),
 					resource.TestCheckResourceAttr(resName, "config.0.helpers.0.network", "true"),
 					resource.TestCheckResourceAttr(resName, "alerts.0.cpu", "60"),
+					testAccCheckResourceAttrNotEqual(resName, "alerts.0.io", "0"),
 				),
 			},
 			{
@@ -865,6 +895,8 @@ func TestAccLinodeInstance_upsizeWithoutDisk(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckLinodeInstanceExists(resName, &instance),
 					resource.TestCheckResourceAttr(resName, "specs.0.disk", "25600"),
+					resource.TestCheckResourceAttrSet(resName, "price.0.hourly"),
+					resource.TestCheckResourceAttrSet(resName, "price.0.monthly"),
 					testAccCheckComputeInstanceDisks(&instance,
 						testDiskByFS(linodego.FilesystemExt4, testDiskSize(25344)),
 						testDiskByFS(linodego.FilesystemSwap, testDiskSize(256)),
@@ -948,7 +980,7 @@ func TestAccLinodeInstance_tag(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckLinodeInstanceExists(resName, &instance),
 					resource.TestCheckResourceAttr(resName, "tags.#", "1"),
-					resource.TestCheckResourceAttr(resName, "tags.0", "tf_test"),
+					resource.TestCheckTypeSetElemAttr(resName, "tags.*", "tf_test"),
 				),
 			},
 			// Apply updated tags
@@ -957,8 +989,8 @@ func TestAccLinodeInstance_tag(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckLinodeInstanceExists(resName, &instance),
 					resource.TestCheckResourceAttr(resName, "tags.#", "2"),
-					resource.TestCheckResourceAttr(resName, "tags.0", "tf_test"),
-					resource.TestCheckResourceAttr(resName, "tags.1", "tf_test_2"),
+					resource.TestCheckTypeSetElemAttr(resName, "tags.*", "tf_test"),
+					resource.TestCheckTypeSetElemAttr(resName, "tags.*", "tf_test_2"),
 				),
 			},
 		},
@@ -1297,6 +1329,156 @@ func TestAccLinodeInstance_swapDownsize(t *testing.T) {
 	})
 }
 
+func TestAccLinodeInstance_rotateRootPass(t *testing.T) {
+	t.Parallel()
+
+	var instance linodego.Instance
+	instanceName := acctest.RandomWithPrefix("tf_test")
+	resName := "linode_instance.foobar"
+	createdID := 0
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckLinodeInstanceWithRootPassRotation(instanceName, "terraform-test", 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					func(*terraform.State) error {
+						createdID = instance.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccCheckLinodeInstanceWithRootPassRotation(instanceName, "terraform-test-rotated", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "rotate_root_pass", "1"),
+					resource.TestCheckResourceAttr(resName, "status", "running"),
+					func(*terraform.State) error {
+						if instance.ID != createdID {
+							return fmt.Errorf("expected Instance %d to be kept, got Instance %d", createdID, instance.ID)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccLinodeInstance_imageRebuild(t *testing.T) {
+	t.Parallel()
+
+	var instance linodego.Instance
+	instanceName := acctest.RandomWithPrefix("tf_test")
+	resName := "linode_instance.foobar"
+	createdID := 0
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckLinodeInstanceWithImageRebuild(instanceName, "linode/ubuntu18.04", "terraform-test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					func(*terraform.State) error {
+						createdID = instance.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccCheckLinodeInstanceWithImageRebuild(instanceName, "linode/ubuntu20.04", "terraform-test-rebuilt"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "image", "linode/ubuntu20.04"),
+					resource.TestCheckResourceAttr(resName, "status", "running"),
+					testAccCheckComputeInstanceDisks(&instance,
+						testDiskByFS(linodego.FilesystemExt4, testDiskSize(25088)),
+						testDiskByFS(linodego.FilesystemSwap, testDiskSize(512)),
+					),
+					func(*terraform.State) error {
+						if instance.ID != createdID {
+							return fmt.Errorf("expected Instance %d to be kept, got Instance %d", createdID, instance.ID)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccLinodeInstance_rootDiskSize(t *testing.T) {
+	t.Parallel()
+
+	var instance linodego.Instance
+	instanceName := acctest.RandomWithPrefix("tf_test")
+	resName := "linode_instance.foobar"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckLinodeInstanceWithRootDiskSize(instanceName, publicKeyMaterial, 25600),
+				ExpectError: regexp.MustCompile(`root_disk_size 25600 and swap disk size 512 exceed`),
+			},
+			{
+				Config: testAccCheckLinodeInstanceWithRootDiskSize(instanceName, publicKeyMaterial, 10240),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "root_disk_size", "10240"),
+					resource.TestCheckResourceAttr(resName, "status", "running"),
+					testAccCheckComputeInstanceDisks(&instance,
+						testDiskByFS(linodego.FilesystemExt4, testDiskSize(10240)),
+						testDiskByFS(linodego.FilesystemSwap, testDiskSize(512)),
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLinodeInstance_desiredState(t *testing.T) {
+	t.Parallel()
+
+	var instance linodego.Instance
+	instanceName := acctest.RandomWithPrefix("tf_test")
+	resName := "linode_instance.foobar"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckLinodeInstanceWithDesiredState(instanceName, publicKeyMaterial, "offline"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "desired_state", "offline"),
+					resource.TestCheckResourceAttr(resName, "status", "offline"),
+				),
+			},
+			{
+				Config: testAccCheckLinodeInstanceWithDesiredState(instanceName, publicKeyMaterial, "running"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "desired_state", "running"),
+					resource.TestCheckResourceAttr(resName, "status", "running"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLinodeInstance_diskResizeAndExpanded(t *testing.T) {
 	t.Parallel()
 	var instance linodego.Instance
@@ -1393,6 +1575,10 @@ func TestAccLinodeInstance_diskSlotReorder(t *testing.T) {
 
 					resource.TestCheckResourceAttr(resName, "swap_size", "0"),
 					resource.TestCheckResourceAttr(resName, "status", "running"),
+					resource.TestCheckResourceAttr(resName, "hypervisor", "kvm"),
+					resource.TestCheckResourceAttr(resName, "maintenance.#", "0"),
+					resource.TestCheckResourceAttrSet(resName, "created"),
+					resource.TestCheckResourceAttrSet(resName, "updated"),
 				),
 			},
 		},
@@ -1411,11 +1597,28 @@ func TestAccLinodeInstance_privateNetworking(t *testing.T) {
 		CheckDestroy: testAccCheckLinodeInstanceDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccCheckLinodeInstanceConfigPrivateNetworking(instanceName, publicKeyMaterial),
+				Config: testAccCheckLinodeInstanceConfigWithPrivateIP(instanceName, publicKeyMaterial, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					testAccCheckLinodeInstanceAttributesPrivateNetworking("linode_instance.foobar"),
+					resource.TestCheckResourceAttr(resName, "private_ip", "true"),
+				),
+			},
+			{
+				Config: testAccCheckLinodeInstanceConfigWithPrivateIP(instanceName, publicKeyMaterial, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "private_ip", "false"),
+					resource.TestCheckResourceAttr(resName, "private_ip_address", ""),
+				),
+			},
+			{
+				Config: testAccCheckLinodeInstanceConfigWithPrivateIP(instanceName, publicKeyMaterial, true),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckLinodeInstanceExists(resName, &instance),
 					testAccCheckLinodeInstanceAttributesPrivateNetworking("linode_instance.foobar"),
 					resource.TestCheckResourceAttr(resName, "private_ip", "true"),
+					resource.TestCheckResourceAttrSet(resName, "private_ip_address"),
 				),
 			},
 		},
@@ -1512,6 +1715,8 @@ func TestAccLinodeInstance_stackScriptDisk(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckLinodeInstanceExists(resName, &instance),
 					resource.TestCheckResourceAttr(resName, "label", instanceName),
+					resource.TestCheckResourceAttr(resName, "disk.0.filesystem_path", "/dev/sda"),
+					resource.TestCheckResourceAttr(resName, "disk_filesystem_paths.disk", "/dev/sda"),
 					// resource.TestCheckResourceAttr(resName, "type", "g6-nanode-1"),
 					// resource.TestCheckResourceAttr(resName, "region", "us-east"),
 					// resource.TestCheckResourceAttr(resName, "group", "tf_test"),
@@ -1522,71 +1727,427 @@ func TestAccLinodeInstance_stackScriptDisk(t *testing.T) {
 	})
 }
 
-func testAccCheckLinodeInstanceExists(name string, instance *linodego.Instance) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(*ProviderMeta).Client
-
-		rs, ok := s.RootModule().Resources[name]
-		if !ok {
-			return fmt.Errorf("Not found: %s", name)
-		}
+func TestAccLinodeInstance_invalidType(t *testing.T) {
+	t.Parallel()
 
-		if rs.Primary.ID == "" {
-			return fmt.Errorf("No ID is set")
-		}
+	instanceName := acctest.RandomWithPrefix("tf_test")
 
-		id, err := strconv.Atoi(rs.Primary.ID)
-		if err != nil {
-			return fmt.Errorf("Error parsing %v to int", rs.Primary.ID)
-		}
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckLinodeInstanceWithType(instanceName, publicKeyMaterial, "g6-invalid-1"),
+				ExpectError: regexp.MustCompile(`instance type "g6-invalid-1" does not exist`),
+			},
+		},
+	})
+}
 
-		found, err := client.GetInstance(context.Background(), id)
-		if err != nil {
-			return fmt.Errorf("Error retrieving state of Instance %s: %s", rs.Primary.Attributes["label"], err)
-		}
+func TestAccLinodeInstance_invalidConfigRootDevice(t *testing.T) {
+	t.Parallel()
 
-		*instance = *found
+	instanceName := acctest.RandomWithPrefix("tf_test")
 
-		return nil
-	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckLinodeInstanceWithConfigLimits(instanceName, "/dev/sdb", 0),
+				ExpectError: regexp.MustCompile(`has root_device "/dev/sdb" but no device is defined in slot "sdb"`),
+			},
+		},
+	})
 }
 
-func testAccCheckLinodeInstanceDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ProviderMeta).Client
-	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "linode_instance" {
-			continue
-		}
-
-		id, err := strconv.Atoi(rs.Primary.ID)
-		if err != nil {
-			return fmt.Errorf("Error parsing %v as int", rs.Primary.ID)
-		}
+func TestAccLinodeInstance_invalidConfigMemoryLimit(t *testing.T) {
+	t.Parallel()
 
-		if id == 0 {
-			return fmt.Errorf("should not have Linode ID 0")
-		}
+	instanceName := acctest.RandomWithPrefix("tf_test")
 
-		_, err = client.GetInstance(context.Background(), id)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckLinodeInstanceWithConfigLimits(instanceName, "/dev/sda", -1),
+				ExpectError: regexp.MustCompile(`memory_limit to be at least \(0\)`),
+			},
+			{
+				Config:      testAccCheckLinodeInstanceWithConfigLimits(instanceName, "/dev/sda", 4096),
+				ExpectError: regexp.MustCompile(`has memory_limit 4096 which exceeds the 1024 MB of memory of type "g6-nanode-1"`),
+			},
+		},
+	})
+}
 
-		if err == nil {
-			return fmt.Errorf("should not find Linode ID %d existing after delete", id)
-		}
+func TestAccLinodeInstance_firewall(t *testing.T) {
+	t.Parallel()
 
-		if apiErr, ok := err.(*linodego.Error); ok && apiErr.Code != 404 {
-			return fmt.Errorf("Error getting Linode ID %d: %s", id, err)
-		}
-	}
+	resName := "linode_instance.foobar"
+	var instance linodego.Instance
+	instanceName := acctest.RandomWithPrefix("tf_test")
 
-	return nil
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckLinodeInstanceWithFirewall(instanceName, "one"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttrPair(resName, "firewall_id", "linode_firewall.one", "id"),
+					resource.TestCheckResourceAttr(resName, "firewall_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resName, "firewall_ids.0", "linode_firewall.one", "id"),
+				),
+			},
+			{
+				Config: testAccCheckLinodeInstanceWithFirewall(instanceName, "two"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttrPair(resName, "firewall_id", "linode_firewall.two", "id"),
+					resource.TestCheckResourceAttr(resName, "firewall_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resName, "firewall_ids.0", "linode_firewall.two", "id"),
+				),
+			},
+		},
+	})
 }
 
-func testAccCheckLinodeInstanceAttributesPrivateNetworking(n string) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		rs, ok := s.RootModule().Resources[n]
-		if !ok {
-			return fmt.Errorf("should have found linode_instance resource %s", n)
-		}
+func TestAccLinodeInstance_inlineFirewall(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_instance.foobar"
+	var instance linodego.Instance
+	instanceName := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckLinodeInstanceWithInlineFirewall(instanceName, "ssh"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					testAccCheckLinodeInstanceInlineFirewallAttached(resName),
+					resource.TestCheckResourceAttr(resName, "firewall.#", "1"),
+					resource.TestCheckResourceAttrSet(resName, "firewall.0.id"),
+					resource.TestCheckResourceAttr(resName, "firewall.0.label", instanceName),
+					resource.TestCheckResourceAttr(resName, "firewall.0.inbound.#", "1"),
+					resource.TestCheckResourceAttr(resName, "firewall.0.inbound.0.preset", "ssh"),
+					resource.TestCheckResourceAttr(resName, "firewall.0.inbound_policy", "DROP"),
+				),
+			},
+			{
+				Config: testAccCheckLinodeInstanceWithInlineFirewall(instanceName, "https"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					testAccCheckLinodeInstanceInlineFirewallAttached(resName),
+					resource.TestCheckResourceAttr(resName, "firewall.0.inbound.0.preset", "https"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLinodeInstance_configHelpers(t *testing.T) {
+	t.Parallel()
+	var instance linodego.Instance
+	instanceName := acctest.RandomWithPrefix("tf_test")
+	resName := "linode_instance.foobar"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckLinodeInstanceWithConfigHelpers(instanceName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "config.0.helpers.0.updatedb_disabled", "true"),
+					resource.TestCheckResourceAttr(resName, "config.0.helpers.0.distro", "true"),
+					resource.TestCheckResourceAttr(resName, "config.0.helpers.0.modules_dep", "true"),
+					resource.TestCheckResourceAttr(resName, "config.0.helpers.0.network", "true"),
+					resource.TestCheckResourceAttr(resName, "config.0.helpers.0.devtmpfs_automount", "true"),
+				),
+			},
+			{
+				Config: testAccCheckLinodeInstanceWithConfigHelpers(instanceName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "config.0.helpers.0.updatedb_disabled", "false"),
+					resource.TestCheckResourceAttr(resName, "config.0.helpers.0.distro", "false"),
+					resource.TestCheckResourceAttr(resName, "config.0.helpers.0.modules_dep", "false"),
+					resource.TestCheckResourceAttr(resName, "config.0.helpers.0.network", "false"),
+					resource.TestCheckResourceAttr(resName, "config.0.helpers.0.devtmpfs_automount", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLinodeInstance_restoreFromBackup(t *testing.T) {
+	t.Parallel()
+	var instance linodego.Instance
+	instanceName := acctest.RandomWithPrefix("tf_test")
+	resName := "linode_instance.restored"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckLinodeInstanceWithRestoreFromBackup(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "status", "running"),
+					resource.TestCheckResourceAttrPair(
+						resName, "restore_from_backup.0.linode_id", "linode_instance.foobar", "id"),
+					resource.TestCheckResourceAttrPair(
+						resName, "restore_from_backup.0.backup_id", "linode_instance_snapshot.foobar", "backup_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLinodeInstance_upsizeExpandDisk(t *testing.T) {
+	t.Parallel()
+	var instance linodego.Instance
+	instanceName := acctest.RandomWithPrefix("tf_test")
+	resName := "linode_instance.foobar"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckLinodeInstanceWithType(instanceName, publicKeyMaterial, "g6-nanode-1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "specs.0.disk", "25600"),
+					testAccCheckComputeInstanceDisks(&instance,
+						testDiskByFS(linodego.FilesystemExt4, testDiskSize(25344)),
+						testDiskByFS(linodego.FilesystemSwap, testDiskSize(256)),
+					),
+				),
+			},
+			{
+				Config: testAccCheckLinodeInstanceConfigUpsizeExpandDisk(instanceName, publicKeyMaterial),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "specs.0.disk", "51200"),
+					resource.TestCheckResourceAttr(resName, "disk_expansion", "true"),
+					testAccCheckComputeInstanceDisks(&instance,
+						testDiskByFS(linodego.FilesystemExt4, testDiskSize(50944)),
+						testDiskByFS(linodego.FilesystemSwap, testDiskSize(256)),
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLinodeInstance_upsizeAutoDiskResize(t *testing.T) {
+	t.Parallel()
+	var instance linodego.Instance
+	instanceName := acctest.RandomWithPrefix("tf_test")
+	resName := "linode_instance.foobar"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckLinodeInstanceWithType(instanceName, publicKeyMaterial, "g6-nanode-1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "specs.0.disk", "25600"),
+				),
+			},
+			{
+				Config: testAccCheckLinodeInstanceConfigUpsizeAutoDiskResize(instanceName, publicKeyMaterial),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "specs.0.disk", "51200"),
+					resource.TestCheckResourceAttr(resName, "allow_auto_disk_resize", "true"),
+					testAccCheckComputeInstanceDisks(&instance,
+						testDiskByFS(linodego.FilesystemExt4, testDiskSize(50944)),
+						testDiskByFS(linodego.FilesystemSwap, testDiskSize(256)),
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLinodeInstance_invalidBootConfigLabel(t *testing.T) {
+	t.Parallel()
+
+	instanceName := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckLinodeInstanceWithBootConfigLabel(instanceName, "configb"),
+				ExpectError: regexp.MustCompile(`boot_config_label "configb" does not match any declared config`),
+			},
+		},
+	})
+}
+
+func TestAccLinodeInstance_invalidKernel(t *testing.T) {
+	t.Parallel()
+
+	instanceName := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckLinodeInstanceWithKernel(instanceName, "linode/not-a-kernel"),
+				ExpectError: regexp.MustCompile(`kernel "linode/not-a-kernel" which is not an available kernel`),
+			},
+		},
+	})
+}
+
+func testAccCheckLinodeInstanceInlineFirewallAttached(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*ProviderMeta).Client
+
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		linodeID, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Error parsing %v to int", rs.Primary.ID)
+		}
+		firewallID, err := strconv.Atoi(rs.Primary.Attributes["firewall.0.id"])
+		if err != nil {
+			return fmt.Errorf("Error parsing firewall ID %v to int", rs.Primary.Attributes["firewall.0.id"])
+		}
+
+		device, err := getInstanceFirewallDevice(context.Background(), client, firewallID, linodeID)
+		if err != nil {
+			return fmt.Errorf("Error getting devices of Firewall %d: %s", firewallID, err)
+		}
+		if device == nil {
+			return fmt.Errorf("Linode instance %d is not attached to Firewall %d", linodeID, firewallID)
+		}
+		return nil
+	}
+}
+
+func TestAccLinodeInstance_stackScriptAnyImage(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_instance.foobar"
+	var instance linodego.Instance
+	instanceName := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckLinodeInstanceWithStackScriptImages(instanceName, "linode/debian9", "linode/alpine3.13"),
+				ExpectError: regexp.MustCompile("is not compatible with StackScript"),
+			},
+			{
+				Config: testAccCheckLinodeInstanceWithStackScriptImages(instanceName, "any/all", "linode/alpine3.13"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "image", "linode/alpine3.13"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLinodeInstanceExists(name string, instance *linodego.Instance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*ProviderMeta).Client
+
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Error parsing %v to int", rs.Primary.ID)
+		}
+
+		found, err := client.GetInstance(context.Background(), id)
+		if err != nil {
+			return fmt.Errorf("Error retrieving state of Instance %s: %s", rs.Primary.Attributes["label"], err)
+		}
+
+		*instance = *found
+
+		return nil
+	}
+}
+
+func testAccCheckLinodeInstanceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ProviderMeta).Client
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "linode_instance" {
+			continue
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Error parsing %v as int", rs.Primary.ID)
+		}
+
+		if id == 0 {
+			return fmt.Errorf("should not have Linode ID 0")
+		}
+
+		_, err = client.GetInstance(context.Background(), id)
+
+		if err == nil {
+			return fmt.Errorf("should not find Linode ID %d existing after delete", id)
+		}
+
+		if apiErr, ok := err.(*linodego.Error); ok && apiErr.Code != 404 {
+			return fmt.Errorf("Error getting Linode ID %d: %s", id, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLinodeInstanceAttributesPrivateNetworking(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("should have found linode_instance resource %s", n)
+		}
 
 		if rs.Primary.ID == "" {
 			return fmt.Errorf("should have a Linode ID")
@@ -1877,6 +2438,224 @@ resource "linode_instance" "foobar" {
 }`, instance, pubkey)
 }
 
+func testAccCheckLinodeInstanceNoGroup(instance string, pubkey string) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label = "%s"
+	type = "g6-nanode-1"
+	image = "linode/ubuntu18.04"
+	region = "us-east"
+	root_pass = "terraform-test"
+	swap_size = 256
+	authorized_keys = ["%s"]
+}`, instance, pubkey)
+}
+
+func testAccCheckLinodeInstanceWithFirewall(instance, firewall string) string {
+	firewalls := ""
+	for _, name := range []string{"one", "two"} {
+		firewalls += fmt.Sprintf(`
+resource "linode_firewall" "%[2]s" {
+	label = "%[1]s-%[2]s"
+
+	inbound {
+		label    = "ssh"
+		action   = "ACCEPT"
+		protocol = "TCP"
+		ports    = "22"
+		ipv4     = ["0.0.0.0/0"]
+	}
+	inbound_policy  = "DROP"
+	outbound_policy = "ACCEPT"
+
+	lifecycle {
+		ignore_changes = [linodes]
+	}
+}
+`, instance, name)
+	}
+
+	return firewalls + fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label       = "%s"
+	type        = "g6-nanode-1"
+	image       = "linode/alpine3.12"
+	region      = "us-east"
+	root_pass   = "terraform-test"
+	firewall_id = linode_firewall.%s.id
+}`, instance, firewall)
+}
+
+func testAccCheckLinodeInstanceWithConfigHelpers(instance string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label = "%s"
+	type = "g6-nanode-1"
+	region = "us-east"
+	config {
+		label = "config"
+		kernel = "linode/latest-64bit"
+		helpers {
+			updatedb_disabled = %[2]t
+			distro = %[2]t
+			modules_dep = %[2]t
+			network = %[2]t
+			devtmpfs_automount = %[2]t
+		}
+	}
+}`, instance, enabled)
+}
+
+func testAccCheckLinodeInstanceWithConfigLimits(instance, rootDevice string, memoryLimit int) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label = "%s"
+	type = "g6-nanode-1"
+	region = "us-east"
+
+	disk {
+		label = "disk"
+		size = 3000
+	}
+
+	config {
+		label = "config"
+		kernel = "linode/latest-64bit"
+		root_device = "%s"
+		memory_limit = %d
+		devices {
+			sda {
+				disk_label = "disk"
+			}
+		}
+	}
+}`, instance, rootDevice, memoryLimit)
+}
+
+func testAccCheckLinodeInstanceWithRestoreFromBackup(instance string) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label           = "%[1]s"
+	type            = "g6-nanode-1"
+	region          = "us-east"
+	image           = "linode/alpine3.12"
+	backups_enabled = true
+}
+
+resource "linode_instance_snapshot" "foobar" {
+	linode_id = linode_instance.foobar.id
+	label     = "%[1]s"
+}
+
+resource "linode_instance" "restored" {
+	label  = "%[1]s_r"
+	type   = "g6-nanode-1"
+	region = "us-east"
+
+	restore_from_backup {
+		linode_id = linode_instance.foobar.id
+		backup_id = linode_instance_snapshot.foobar.backup_id
+	}
+}`, instance)
+}
+
+func testAccCheckLinodeInstanceWithBootConfigLabel(instance, bootConfigLabel string) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label = "%s"
+	type = "g6-nanode-1"
+	region = "us-east"
+	boot_config_label = "%s"
+
+	disk {
+		label = "disk"
+		size = 3000
+	}
+
+	config {
+		label = "configa"
+		kernel = "linode/latest-64bit"
+		devices {
+			sda {
+				disk_label = "disk"
+			}
+		}
+	}
+}`, instance, bootConfigLabel)
+}
+
+func testAccCheckLinodeInstanceWithKernel(instance, kernel string) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label = "%s"
+	type = "g6-nanode-1"
+	region = "us-east"
+
+	disk {
+		label = "disk"
+		size = 3000
+	}
+
+	config {
+		label = "config"
+		kernel = "%s"
+		devices {
+			sda {
+				disk_label = "disk"
+			}
+		}
+	}
+}`, instance, kernel)
+}
+
+func testAccCheckLinodeInstanceWithInlineFirewall(instance, preset string) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label     = "%[1]s"
+	type      = "g6-nanode-1"
+	image     = "linode/alpine3.12"
+	region    = "us-east"
+	root_pass = "terraform-test"
+
+	firewall {
+		label = "%[1]s"
+
+		inbound {
+			label  = "%[2]s"
+			action = "ACCEPT"
+			preset = "%[2]s"
+			ipv4   = ["0.0.0.0/0"]
+		}
+		inbound_policy  = "DROP"
+		outbound_policy = "ACCEPT"
+	}
+}`, instance, preset)
+}
+
+func testAccCheckLinodeInstanceWithStackScriptImages(instance, stackscriptImage, image string) string {
+	return fmt.Sprintf(`
+resource "linode_stackscript" "foo-script" {
+	label = "%s"
+	description = "Installs a Package"
+
+	script = <<EOF
+#!/bin/bash
+echo "hello this is a stack script"
+	EOF
+	images = ["%s"]
+	rev_note = "hello version"
+}
+
+resource "linode_instance" "foobar" {
+	label = "%s"
+	type = "g6-nanode-1"
+	region = "us-east"
+	image = "%s"
+	root_pass = "terraform-test"
+	stackscript_id = linode_stackscript.foo-script.id
+}`, instance, stackscriptImage, instance, image)
+}
+
 func testAccCheckLinodeInstanceDontPoll(instance string) string {
 	//lintignore:AT004
 	return `
@@ -1940,6 +2719,60 @@ resource "linode_instance" "foobar" {
 }`, instance, swapSize, pubkey)
 }
 
+func testAccCheckLinodeInstanceWithRootPassRotation(instance string, rootPass string, rotation int) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label = "%s"
+	group = "tf_test"
+	type = "g6-nanode-1"
+	image = "linode/ubuntu18.04"
+	region = "us-east"
+	root_pass = "%s"
+	rotate_root_pass = %d
+}`, instance, rootPass, rotation)
+}
+
+func testAccCheckLinodeInstanceWithImageRebuild(instance string, image string, rootPass string) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label = "%s"
+	group = "tf_test"
+	type = "g6-nanode-1"
+	image = "%s"
+	region = "us-east"
+	root_pass = "%s"
+	allow_image_rebuild = true
+}`, instance, image, rootPass)
+}
+
+func testAccCheckLinodeInstanceWithRootDiskSize(instance string, pubkey string, rootDiskSize int) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label = "%s"
+	group = "tf_test"
+	type = "g6-nanode-1"
+	image = "linode/ubuntu18.04"
+	region = "us-east"
+	root_pass = "terraform-test"
+	root_disk_size = %d
+	authorized_keys = ["%s"]
+}`, instance, rootDiskSize, pubkey)
+}
+
+func testAccCheckLinodeInstanceWithDesiredState(instance string, pubkey string, desiredState string) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label = "%s"
+	group = "tf_test"
+	type = "g6-nanode-1"
+	image = "linode/ubuntu18.04"
+	region = "us-east"
+	root_pass = "terraform-test"
+	desired_state = "%s"
+	authorized_keys = ["%s"]
+}`, instance, desiredState, pubkey)
+}
+
 func testAccCheckLinodeInstanceWithFullDisk(instance string, pubkey string, swapSize int) string {
 	ssName := acctest.RandomWithPrefix("tf_test")
 	return fmt.Sprintf(`
@@ -2727,6 +3560,21 @@ resource "linode_instance" "foobar" {
 }`, instance, pubkey)
 }
 
+func testAccCheckLinodeInstanceConfigUpsizeAutoDiskResize(instance string, pubkey string) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label = "%s_resized"
+	type = "g6-standard-1"
+	allow_auto_disk_resize = true
+	image = "linode/ubuntu18.04"
+	region = "us-east"
+	root_pass = "terraform-test"
+	swap_size = 256
+	authorized_keys = ["%s"]
+	group = "tf_test"
+}`, instance, pubkey)
+}
+
 func testAccCheckLinodeInstanceConfigUpsizeExpandDisk(instance string, pubkey string) string {
 	return fmt.Sprintf(`
 resource "linode_instance" "foobar" {
@@ -2743,6 +3591,10 @@ resource "linode_instance" "foobar" {
 }
 
 func testAccCheckLinodeInstanceConfigPrivateNetworking(instance string, pubkey string) string {
+	return testAccCheckLinodeInstanceConfigWithPrivateIP(instance, pubkey, true)
+}
+
+func testAccCheckLinodeInstanceConfigWithPrivateIP(instance string, pubkey string, privateIP bool) string {
 	return fmt.Sprintf(`
 resource "linode_instance" "foobar" {
 	label = "%s"
@@ -2751,10 +3603,10 @@ resource "linode_instance" "foobar" {
 	region = "us-east"
 	root_pass = "terraform-test"
 	swap_size = 256
-	private_ip = true
+	private_ip = %t
 	authorized_keys = ["%s"]
 	group = "tf_test"
-}`, instance, pubkey)
+}`, instance, privateIP, pubkey)
 }
 
 func testAccCheckLinodeInstanceAuthorizedUsers(instance string, pubkey string) string {
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/linode/linodego"
+	"github.com/linode/linodego/k8s"
 	k8scondition "github.com/linode/linodego/k8s/pkg/condition"
 )
 
@@ -79,6 +80,13 @@ func resourceLinodeLKECluster() *schema.Resource {
 				Computed:    true,
 				Description: "The status of the cluster.",
 			},
+			"wait_for_api_server": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "If true, creating the cluster waits until its Kubernetes API server responds " +
+					"through the generated kubeconfig.",
+			},
 			"pool": {
 				Type: schema.TypeList,
 				Elem: &schema.Resource{
@@ -118,6 +126,16 @@ func resourceLinodeLKECluster() *schema.Resource {
 										Description: `The status of the node.`,
 										Computed:    true,
 									},
+									"ip_address": {
+										Type:        schema.TypeString,
+										Description: "The public IPv4 address of the underlying Linode instance.",
+										Computed:    true,
+									},
+									"private_ip_address": {
+										Type:        schema.TypeString,
+										Description: "The private IPv4 address of the underlying Linode instance.",
+										Computed:    true,
+									},
 								},
 							},
 							Computed:    true,
@@ -166,7 +184,12 @@ func resourceLinodeLKEClusterRead(ctx context.Context, d *schema.ResourceData, m
 	d.Set("tags", cluster.Tags)
 	d.Set("status", cluster.Status)
 	d.Set("kubeconfig", kubeconfig.KubeConfig)
-	d.Set("pool", flattenLinodeLKEClusterPools(pools))
+	nodeIPs, err := getLinodeLKEClusterNodeIPs(ctx, &client, pools)
+	if err != nil {
+		return diag.Errorf("failed to get node IPs for LKE cluster %d: %s", id, err)
+	}
+
+	d.Set("pool", flattenLinodeLKEClusterPools(pools, nodeIPs))
 	d.Set("api_endpoints", flattenLinodeLKEClusterAPIEndpoints(endpoints))
 	return nil
 }
@@ -203,6 +226,17 @@ func resourceLinodeLKEClusterCreate(ctx context.Context, d *schema.ResourceData,
 	client.WaitForLKEClusterConditions(ctx, cluster.ID, linodego.LKEClusterPollOptions{
 		TimeoutSeconds: 10 * 60,
 	}, k8scondition.ClusterHasReadyNode)
+
+	if d.Get("wait_for_api_server").(bool) {
+		ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+		defer cancel()
+
+		pollMs := meta.(*ProviderMeta).Config.LKEEventPollMilliseconds
+		if err := waitForLKEClusterAPIServer(ctx, &client, pollMs, cluster.ID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceLinodeLKEClusterRead(ctx, d, meta)
 }
 
@@ -447,6 +481,41 @@ main:
 	}
 }
 
+// waitForLKEClusterAPIServer polls until the Kubernetes API server of the cluster responds through
+// the cluster's kubeconfig. The cluster may report being ready before its API server is reachable.
+func waitForLKEClusterAPIServer(ctx context.Context, client *linodego.Client, pollMs, clusterID int) error {
+	eventTicker := time.NewTicker(time.Duration(pollMs) * time.Millisecond)
+	defer eventTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for LKE Cluster (%d) API server to respond", clusterID)
+
+		case <-eventTicker.C:
+			kubeconfig, err := client.GetLKEClusterKubeconfig(ctx, clusterID)
+			if err != nil {
+				log.Printf("[DEBUG] LKE Cluster (%d) kubeconfig is not available yet: %s", clusterID, err)
+				continue
+			}
+
+			clientset, err := k8s.BuildClientsetFromConfig(kubeconfig, nil)
+			if err != nil {
+				return fmt.Errorf("failed to build a client for LKE Cluster (%d): %s", clusterID, err)
+			}
+
+			// the request is bound to ctx, as the client has no timeout of its own
+			if err := clientset.Discovery().RESTClient().Get().AbsPath("/version").Do(ctx).Error(); err != nil {
+				log.Printf("[DEBUG] LKE Cluster (%d) API server is not responding yet: %s", clusterID, err)
+				continue
+			}
+
+			log.Printf("[DEBUG] finished waiting for LKE Cluster (%d) API server to respond", clusterID)
+			return nil
+		}
+	}
+}
+
 func waitForClusterPoolsToStartRecycle(
 	ctx context.Context, client *linodego.Client, pollMs, clusterID int, pools []linodego.LKEClusterPool,
 ) (<-chan int, <-chan error) {
@@ -569,7 +638,34 @@ func recycleLKECluster(ctx context.Context, meta *ProviderMeta, id int, pools []
 	}
 }
 
-func flattenLinodeLKEClusterPools(pools []linodego.LKEClusterPool) []map[string]interface{} {
+// getLinodeLKEClusterNodeIPs gets the IP addresses of the instances backing the nodes of pools, keyed by
+// instance ID. Nodes without an instance yet, or whose instance no longer exists, are skipped.
+func getLinodeLKEClusterNodeIPs(
+	ctx context.Context, client *linodego.Client, pools []linodego.LKEClusterPool,
+) (map[int]*linodego.InstanceIPAddressResponse, error) {
+	nodeIPs := make(map[int]*linodego.InstanceIPAddressResponse)
+	for _, pool := range pools {
+		for _, node := range pool.Linodes {
+			if node.InstanceID == 0 {
+				continue
+			}
+
+			ips, err := client.GetInstanceIPAddresses(ctx, node.InstanceID)
+			if err != nil {
+				if isLinodeNotFound(err) {
+					continue
+				}
+				return nil, fmt.Errorf("failed to get IPs of node %s (instance %d): %s", node.ID, node.InstanceID, err)
+			}
+			nodeIPs[node.InstanceID] = ips
+		}
+	}
+	return nodeIPs, nil
+}
+
+func flattenLinodeLKEClusterPools(
+	pools []linodego.LKEClusterPool, nodeIPs map[int]*linodego.InstanceIPAddressResponse,
+) []map[string]interface{} {
 	flattened := make([]map[string]interface{}, len(pools))
 	for i, pool := range pools {
 
@@ -580,6 +676,15 @@ func flattenLinodeLKEClusterPools(pools []linodego.LKEClusterPool) []map[string]
 				"instance_id": node.InstanceID,
 				"status":      node.Status,
 			}
+
+			if ips, ok := nodeIPs[node.InstanceID]; ok && ips.IPv4 != nil {
+				if len(ips.IPv4.Public) > 0 {
+					nodes[i]["ip_address"] = ips.IPv4.Public[0].Address
+				}
+				if len(ips.IPv4.Private) > 0 {
+					nodes[i]["private_ip_address"] = ips.IPv4.Private[0].Address
+				}
+			}
 		}
 
 		flattened[i] = map[string]interface{}{
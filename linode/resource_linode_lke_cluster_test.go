@@ -224,6 +224,28 @@ func TestAccLinodeLKECluster_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(testLKEClusterResName, "pool.0.nodes.#", "3"),
 					resource.TestCheckResourceAttrSet(testLKEClusterResName, "id"),
 					resource.TestCheckResourceAttrSet(testLKEClusterResName, "pool.0.id"),
+					resource.TestCheckResourceAttrSet(testLKEClusterResName, "pool.0.nodes.0.ip_address"),
+					resource.TestCheckResourceAttrSet(testLKEClusterResName, "kubeconfig"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLinodeLKECluster_waitForAPIServer(t *testing.T) {
+	t.Parallel()
+
+	clusterName := acctest.RandomWithPrefix("tf_test")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeLKEClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckLinodeLKEClusterWaitForAPIServer(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testLKEClusterResName, "wait_for_api_server", "true"),
+					resource.TestCheckResourceAttr(testLKEClusterResName, "status", "ready"),
 					resource.TestCheckResourceAttrSet(testLKEClusterResName, "kubeconfig"),
 				),
 			},
@@ -392,6 +414,21 @@ resource "linode_lke_cluster" "test" {
 }`, name)
 }
 
+func testAccCheckLinodeLKEClusterWaitForAPIServer(name string) string {
+	return fmt.Sprintf(`
+resource "linode_lke_cluster" "test" {
+	label               = "%s"
+	region              = "us-central"
+	k8s_version         = "1.20"
+	wait_for_api_server = true
+
+	pool {
+		type  = "g6-standard-2"
+		count = 1
+	}
+}`, name)
+}
+
 func testAccCheckLinodeLKEClusterManyPools(name, k8sVersion string) string {
 	return fmt.Sprintf(`
 resource "linode_lke_cluster" "test" {
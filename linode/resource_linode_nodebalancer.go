@@ -17,17 +17,17 @@ func resourceLinodeNodeBalancerTransfer() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"in": {
 				Type:        schema.TypeFloat,
-				Description: "The total transfer, in MB, used by this NodeBalancer this month",
+				Description: "The total inbound transfer, in MB, used for this NodeBalancer this month",
 				Computed:    true,
 			},
 			"out": {
 				Type:        schema.TypeFloat,
-				Description: "The total inbound transfer, in MB, used for this NodeBalancer this month",
+				Description: "The total outbound transfer, in MB, used for this NodeBalancer this month",
 				Computed:    true,
 			},
 			"total": {
 				Type:        schema.TypeFloat,
-				Description: "The total outbound transfer, in MB, used for this NodeBalancer this month",
+				Description: "The total transfer, in MB, used by this NodeBalancer this month",
 				Computed:    true,
 			},
 		},
@@ -94,6 +94,13 @@ func resourceLinodeNodeBalancer() *schema.Resource {
 				Computed:    true,
 				Elem:        resourceLinodeNodeBalancerTransfer(),
 			},
+			"node_status": {
+				Type: schema.TypeList,
+				Description: "The health of the backends of every config of this NodeBalancer, summed across its " +
+					"configs.",
+				Computed: true,
+				Elem:     resourceLinodeNodeBalancerConfigNodeStatus(),
+			},
 			"tags": {
 				Type:        schema.TypeSet,
 				Elem:        &schema.Schema{Type: schema.TypeString},
@@ -113,7 +120,7 @@ func resourceLinodeNodeBalancerRead(d *schema.ResourceData, meta interface{}) er
 
 	nodebalancer, err := client.GetNodeBalancer(context.Background(), int(id))
 	if err != nil {
-		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+		if isLinodeNotFound(err) {
 			log.Printf("[WARN] removing Linode NodeBalancer ID %q from state because it no longer exists", d.Id())
 			d.SetId("")
 			return nil
@@ -137,6 +144,12 @@ func resourceLinodeNodeBalancerRead(d *schema.ResourceData, meta interface{}) er
 		"total": nodebalancer.Transfer.Total,
 	}})
 
+	configs, err := client.ListNodeBalancerConfigs(context.Background(), int(id), nil)
+	if err != nil {
+		return fmt.Errorf("Error listing the configs of Linode NodeBalancer %d: %s", id, err)
+	}
+	d.Set("node_status", flattenNodeBalancerNodeStatus(configs))
+
 	return nil
 }
 
@@ -159,7 +172,7 @@ func resourceLinodeNodeBalancerCreate(d *schema.ResourceData, meta interface{})
 
 	nodebalancer, err := client.CreateNodeBalancer(context.Background(), createOpts)
 	if err != nil {
-		return fmt.Errorf("Error creating a Linode NodeBalancer: %s", err)
+		return fmt.Errorf("Error creating a Linode NodeBalancer: %s", formatLinodeError(err))
 	}
 	d.SetId(fmt.Sprintf("%d", nodebalancer.ID))
 
@@ -179,7 +192,16 @@ func resourceLinodeNodeBalancerUpdate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error fetching data about the current NodeBalancer: %s", err)
 	}
 
-	if d.HasChanges("label", "client_conn_throttle", "tags") {
+	if d.HasChange("tags") {
+		oldTags, newTags := d.GetChange("tags")
+		err := updateResourceTags(context.Background(), client, linodego.EntityNodebalancer, nodebalancer.ID,
+			expandStringSet(oldTags.(*schema.Set)), expandStringSet(newTags.(*schema.Set)))
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.HasChanges("label", "client_conn_throttle") {
 		label := d.Get("label").(string)
 		clientConnThrottle := d.Get("client_conn_throttle").(int)
 
@@ -189,15 +211,8 @@ func resourceLinodeNodeBalancerUpdate(d *schema.ResourceData, meta interface{})
 			ClientConnThrottle: &clientConnThrottle,
 		}
 
-		tags := []string{}
-		for _, tag := range d.Get("tags").(*schema.Set).List() {
-			tags = append(tags, tag.(string))
-		}
-
-		updateOpts.Tags = &tags
-
 		if nodebalancer, err = client.UpdateNodeBalancer(context.Background(), nodebalancer.ID, updateOpts); err != nil {
-			return err
+			return fmt.Errorf("Error updating Linode NodeBalancer %d: %s", id, formatLinodeError(err))
 		}
 	}
 
@@ -216,3 +231,19 @@ func resourceLinodeNodeBalancerDelete(d *schema.ResourceData, meta interface{})
 	}
 	return nil
 }
+
+// flattenNodeBalancerNodeStatus sums the backend health of the given NodeBalancer configs.
+func flattenNodeBalancerNodeStatus(configs []linodego.NodeBalancerConfig) []map[string]interface{} {
+	up, down := 0, 0
+	for _, config := range configs {
+		if config.NodesStatus == nil {
+			continue
+		}
+		up += config.NodesStatus.Up
+		down += config.NodesStatus.Down
+	}
+	return []map[string]interface{}{{
+		"up":   up,
+		"down": down,
+	}}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -30,6 +31,51 @@ func resourceLinodeNodeBalancerConfigNodeStatus() *schema.Resource {
 	}
 }
 
+func resourceLinodeNodeBalancerConfigNode() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"label": {
+				Type:        schema.TypeString,
+				Description: "The label for this node. This is for display purposes only.",
+				Required:    true,
+			},
+			"address": {
+				Type: schema.TypeString,
+				Description: "The private IP Address and port (IP:PORT) where this backend can be reached. " +
+					"This must be a private IP address.",
+				Required: true,
+			},
+			"weight": {
+				Type: schema.TypeInt,
+				Description: "Used when picking a backend to serve a request and is not pinned to a single backend " +
+					"yet. Nodes with a higher weight will receive more traffic. (1-255)",
+				ValidateFunc: validation.IntBetween(1, 255),
+				Optional:     true,
+				Computed:     true,
+			},
+			"mode": {
+				Type: schema.TypeString,
+				Description: "The mode this NodeBalancer should use when sending traffic to this backend: " +
+					"accept, reject, drain, backup",
+				ValidateFunc: validation.StringInSlice([]string{"accept", "reject", "drain", "backup"}, false),
+				Optional:     true,
+				Computed:     true,
+			},
+			"id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of this node.",
+				Computed:    true,
+			},
+			"status": {
+				Type: schema.TypeString,
+				Description: "The current status of this node, based on the configured checks of its NodeBalancer " +
+					"Config. (unknown, UP, DOWN)",
+				Computed: true,
+			},
+		},
+	}
+}
+
 func resourceLinodeNodeBalancerConfig() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceLinodeNodeBalancerConfigCreate,
@@ -185,6 +231,15 @@ func resourceLinodeNodeBalancerConfig() *schema.Resource {
 				Computed: true,
 				Elem:     resourceLinodeNodeBalancerConfigNodeStatus(),
 			},
+			"node": {
+				Type: schema.TypeList,
+				Description: "The backend nodes of this NodeBalancerConfig. When the declared nodes change, the config " +
+					"is rebuilt atomically with exactly these nodes. This should not be used together with the " +
+					"linode_nodebalancer_node resource for the same config.",
+				Optional: true,
+				Computed: true,
+				Elem:     resourceLinodeNodeBalancerConfigNode(),
+			},
 		},
 	}
 }
@@ -231,7 +286,7 @@ func resourceLinodeNodeBalancerConfigRead(d *schema.ResourceData, meta interface
 
 	config, err := client.GetNodeBalancerConfig(context.Background(), int(nodebalancerID), int(id))
 	if err != nil {
-		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+		if isLinodeNotFound(err) {
 			log.Printf("[WARN] removing NodeBalancer Config ID %q from state because it no longer exists", d.Id())
 			d.SetId("")
 			return nil
@@ -259,6 +314,13 @@ func resourceLinodeNodeBalancerConfigRead(d *schema.ResourceData, meta interface
 		"down": config.NodesStatus.Down,
 	}})
 
+	nodes, err := client.ListNodeBalancerNodes(context.Background(), nodebalancerID, int(id), nil)
+	if err != nil {
+		return fmt.Errorf("Error listing nodes for Linode NodeBalancerConfig %d: %s", id, err)
+	}
+
+	d.Set("node", flattenLinodeNodeBalancerConfigNodes(nodes, d.Get("node").([]interface{})))
+
 	return nil
 }
 
@@ -288,9 +350,13 @@ func resourceLinodeNodeBalancerConfigCreate(d *schema.ResourceData, meta interfa
 		createOpts.CheckPassive = &checkPassive
 	}
 
+	if nodes, ok := d.GetOk("node"); ok {
+		createOpts.Nodes = expandLinodeNodeBalancerConfigNodes(nodes.([]interface{}))
+	}
+
 	config, err := client.CreateNodeBalancerConfig(context.Background(), nodebalancerID, createOpts)
 	if err != nil {
-		return fmt.Errorf("Error creating a Linode NodeBalancerConfig: %s", err)
+		return fmt.Errorf("Error creating a Linode NodeBalancerConfig: %s", formatLinodeError(err))
 	}
 	d.SetId(fmt.Sprintf("%d", config.ID))
 	d.Set("nodebalancer_id", nodebalancerID)
@@ -330,10 +396,39 @@ func resourceLinodeNodeBalancerConfigUpdate(d *schema.ResourceData, meta interfa
 		updateOpts.CheckPassive = &checkPassive
 	}
 
+	// rebuilding replaces every node, so it is only done when the declared nodes change
+	if d.HasChange("node") {
+		rebuildOpts := linodego.NodeBalancerConfigRebuildOptions{
+			Algorithm:     updateOpts.Algorithm,
+			Check:         updateOpts.Check,
+			Stickiness:    updateOpts.Stickiness,
+			CheckAttempts: updateOpts.CheckAttempts,
+			CheckBody:     updateOpts.CheckBody,
+			CheckInterval: updateOpts.CheckInterval,
+			CheckPath:     updateOpts.CheckPath,
+			CheckPassive:  updateOpts.CheckPassive,
+			CheckTimeout:  updateOpts.CheckTimeout,
+			Port:          updateOpts.Port,
+			Protocol:      updateOpts.Protocol,
+			ProxyProtocol: updateOpts.ProxyProtocol,
+			SSLCert:       updateOpts.SSLCert,
+			SSLKey:        updateOpts.SSLKey,
+			Nodes:         expandLinodeNodeBalancerConfigNodes(d.Get("node").([]interface{})),
+		}
+
+		if _, err = client.RebuildNodeBalancerConfig(
+			context.Background(), int(nodebalancerID), int(id), rebuildOpts,
+		); err != nil {
+			return fmt.Errorf("Error rebuilding Nodebalancer %d Config %d: %s", int(nodebalancerID), int(id), formatLinodeError(err))
+		}
+
+		return resourceLinodeNodeBalancerConfigRead(d, meta)
+	}
+
 	if _, err = client.UpdateNodeBalancerConfig(
 		context.Background(), int(nodebalancerID), int(id), updateOpts,
 	); err != nil {
-		return fmt.Errorf("Error updating Nodebalancer %d Config %d: %s", int(nodebalancerID), int(id), err)
+		return fmt.Errorf("Error updating Nodebalancer %d Config %d: %s", int(nodebalancerID), int(id), formatLinodeError(err))
 	}
 
 	return resourceLinodeNodeBalancerConfigRead(d, meta)
@@ -355,3 +450,54 @@ func resourceLinodeNodeBalancerConfigDelete(d *schema.ResourceData, meta interfa
 	}
 	return nil
 }
+
+func expandLinodeNodeBalancerConfigNodes(nodes []interface{}) []linodego.NodeBalancerNodeCreateOptions {
+	result := make([]linodego.NodeBalancerNodeCreateOptions, 0, len(nodes))
+	for _, nodeRaw := range nodes {
+		node := nodeRaw.(map[string]interface{})
+		result = append(result, linodego.NodeBalancerNodeCreateOptions{
+			Label:   node["label"].(string),
+			Address: node["address"].(string),
+			Weight:  node["weight"].(int),
+			Mode:    linodego.NodeMode(node["mode"].(string)),
+		})
+	}
+	return result
+}
+
+// flattenLinodeNodeBalancerConfigNodes flattens the nodes of a config, keeping the order
+// in which they were declared so that the API's ordering does not produce a diff.
+func flattenLinodeNodeBalancerConfigNodes(
+	nodes []linodego.NodeBalancerNode, declared []interface{},
+) []map[string]interface{} {
+	position := make(map[string]int, len(declared))
+	for i, nodeRaw := range declared {
+		if node, ok := nodeRaw.(map[string]interface{}); ok {
+			position[node["label"].(string)] = i
+		}
+	}
+
+	sorted := make([]linodego.NodeBalancerNode, len(nodes))
+	copy(sorted, nodes)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		pi, iok := position[sorted[i].Label]
+		pj, jok := position[sorted[j].Label]
+		if iok && jok {
+			return pi < pj
+		}
+		return iok && !jok
+	})
+
+	result := make([]map[string]interface{}, len(sorted))
+	for i, node := range sorted {
+		result[i] = map[string]interface{}{
+			"id":      node.ID,
+			"label":   node.Label,
+			"address": node.Address,
+			"weight":  node.Weight,
+			"mode":    string(node.Mode),
+			"status":  node.Status,
+		}
+	}
+	return result
+}
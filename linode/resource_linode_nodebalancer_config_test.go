@@ -253,6 +253,48 @@ func TestAccLinodeNodeBalancerConfig_proxyProtocol(t *testing.T) {
 	})
 }
 
+func TestAccLinodeNodeBalancerConfig_nodes(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_nodebalancer_config.foofig"
+	nodebalancerName := acctest.RandomWithPrefix("tf_test")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeNodeBalancerConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckLinodeNodeBalancerConfigNodes(nodebalancerName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLinodeNodeBalancerConfigExists,
+					resource.TestCheckResourceAttr(resName, "node.#", "2"),
+					resource.TestCheckResourceAttr(resName, "node.0.label", nodebalancerName),
+					resource.TestCheckResourceAttr(resName, "node.0.weight", "50"),
+					resource.TestCheckResourceAttr(resName, "node.1.label", nodebalancerName+"_r"),
+					resource.TestCheckResourceAttr(resName, "node.1.mode", "backup"),
+					resource.TestCheckResourceAttrSet(resName, "node.0.id"),
+					resource.TestCheckResourceAttrSet(resName, "node.0.status"),
+				),
+			},
+			{
+				Config: testAccCheckLinodeNodeBalancerConfigNodesUpdates(nodebalancerName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLinodeNodeBalancerConfigExists,
+					resource.TestCheckResourceAttr(resName, "node.#", "1"),
+					resource.TestCheckResourceAttr(resName, "node.0.label", nodebalancerName),
+					resource.TestCheckResourceAttr(resName, "node.0.weight", "100"),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccStateIDNodeBalancerConfig,
+			},
+		},
+	})
+}
+
 func testAccCheckLinodeNodeBalancerConfigExists(s *terraform.State) error {
 	client := testAccProvider.Meta().(*ProviderMeta).Client
 
@@ -392,3 +434,47 @@ resource "linode_nodebalancer_config" "foofig" {
 }
 `
 }
+
+func testAccCheckLinodeNodeBalancerConfigNodes(nodebalancer string) string {
+	return testAccCheckLinodeInstanceConfigPrivateNetworking(nodebalancer, publicKeyMaterial) +
+		testAccCheckLinodeNodeBalancerBasic(nodebalancer) + fmt.Sprintf(`
+resource "linode_nodebalancer_config" "foofig" {
+	nodebalancer_id = "${linode_nodebalancer.foobar.id}"
+	port = 8080
+	protocol = "http"
+	check = "http"
+	check_path = "/"
+
+	node {
+		label = "%s"
+		address = "${linode_instance.foobar.private_ip_address}:80"
+		weight = 50
+	}
+
+	node {
+		label = "%s_r"
+		address = "${linode_instance.foobar.private_ip_address}:8080"
+		mode = "backup"
+	}
+}
+`, nodebalancer, nodebalancer)
+}
+
+func testAccCheckLinodeNodeBalancerConfigNodesUpdates(nodebalancer string) string {
+	return testAccCheckLinodeInstanceConfigPrivateNetworking(nodebalancer, publicKeyMaterial) +
+		testAccCheckLinodeNodeBalancerBasic(nodebalancer) + fmt.Sprintf(`
+resource "linode_nodebalancer_config" "foofig" {
+	nodebalancer_id = "${linode_nodebalancer.foobar.id}"
+	port = 8080
+	protocol = "http"
+	check = "http"
+	check_path = "/"
+
+	node {
+		label = "%s"
+		address = "${linode_instance.foobar.private_ip_address}:80"
+		weight = 100
+	}
+}
+`, nodebalancer)
+}
@@ -91,7 +91,7 @@ func resourceLinodeNodeBalancerNodeRead(d *schema.ResourceData, meta interface{}
 
 	node, err := client.GetNodeBalancerNode(context.Background(), nodebalancerID, configID, int(id))
 	if err != nil {
-		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+		if isLinodeNotFound(err) {
 			log.Printf("[WARN] removing NodeBalancer Node ID %q from state because it no longer exists", d.Id())
 			d.SetId("")
 			return nil
@@ -163,7 +163,7 @@ func resourceLinodeNodeBalancerNodeCreate(d *schema.ResourceData, meta interface
 	}
 	node, err := client.CreateNodeBalancerNode(context.Background(), int(nodebalancerID), int(configID), createOpts)
 	if err != nil {
-		return fmt.Errorf("Error creating a Linode NodeBalancerNode: %s", err)
+		return fmt.Errorf("Error creating a Linode NodeBalancerNode: %s", formatLinodeError(err))
 	}
 	d.SetId(fmt.Sprintf("%d", node.ID))
 	d.Set("config_id", configID)
@@ -199,7 +199,7 @@ func resourceLinodeNodeBalancerNodeUpdate(d *schema.ResourceData, meta interface
 		context.Background(), nodebalancerID, configID, int(id), updateOpts,
 	); err != nil {
 		return fmt.Errorf("Error updating Linode Nodebalancer %d Config %d Node %d: %s",
-			nodebalancerID, configID, int(id), err)
+			nodebalancerID, configID, int(id), formatLinodeError(err))
 	}
 
 	return resourceLinodeNodeBalancerNodeRead(d, meta)
@@ -68,8 +68,13 @@ func TestAccLinodeNodeBalancer_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resName, "ipv6"),
 					resource.TestCheckResourceAttrSet(resName, "created"),
 					resource.TestCheckResourceAttrSet(resName, "updated"),
+					resource.TestCheckResourceAttr(resName, "transfer.#", "1"),
+					resource.TestCheckResourceAttrSet(resName, "transfer.0.total"),
+					resource.TestCheckResourceAttr(resName, "node_status.#", "1"),
+					resource.TestCheckResourceAttr(resName, "node_status.0.up", "0"),
+					resource.TestCheckResourceAttr(resName, "node_status.0.down", "0"),
 					resource.TestCheckResourceAttr(resName, "tags.#", "1"),
-					resource.TestCheckResourceAttr(resName, "tags.0", "tf_test"),
+					resource.TestCheckTypeSetElemAttr(resName, "tags.*", "tf_test"),
 				),
 			},
 
@@ -108,8 +113,8 @@ func TestAccLinodeNodeBalancer_update(t *testing.T) {
 					resource.TestCheckResourceAttr(resName, "label", fmt.Sprintf("%s_r", nodebalancerName)),
 					resource.TestCheckResourceAttr(resName, "client_conn_throttle", "0"),
 					resource.TestCheckResourceAttr(resName, "tags.#", "2"),
-					resource.TestCheckResourceAttr(resName, "tags.0", "tf_test"),
-					resource.TestCheckResourceAttr(resName, "tags.1", "tf_test_2"),
+					resource.TestCheckTypeSetElemAttr(resName, "tags.*", "tf_test"),
+					resource.TestCheckTypeSetElemAttr(resName, "tags.*", "tf_test_2"),
 				),
 			},
 		},
@@ -2,13 +2,17 @@ package linode
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/linode/linodego"
 )
 
@@ -110,10 +114,20 @@ func resourceLinodeObjectStorageBucket() *schema.Resource {
 				Optional:    true,
 			},
 			"cluster": {
-				Type:        schema.TypeString,
-				Description: "The cluster of the Linode Object Storage Bucket.",
-				Required:    true,
-				ForceNew:    true,
+				Type:         schema.TypeString,
+				Description:  "The cluster of the Linode Object Storage Bucket.",
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"cluster", "region"},
+			},
+			"region": {
+				Type:         schema.TypeString,
+				Description:  "The region of the Linode Object Storage Bucket, used to resolve its cluster.",
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"cluster", "region"},
 			},
 			"label": {
 				Type:        schema.TypeString,
@@ -139,12 +153,26 @@ func resourceLinodeObjectStorageBucket() *schema.Resource {
 				Optional:    true,
 				Elem:        resourceLinodeObjectStorageBucketLifecycleRule(),
 			},
+			"force_destroy": {
+				Type: schema.TypeBool,
+				Description: "If true, all objects and object versions are deleted from the bucket before the bucket is " +
+					"destroyed. (Requires access_key and secret_key)",
+				Optional: true,
+			},
 			"versioning": {
 				Type:        schema.TypeBool,
 				Description: "Whether to enable versioning.",
 				Optional:    true,
 				Computed:    true,
 			},
+			"policy": {
+				Type: schema.TypeString,
+				Description: "The JSON bucket policy document applied to the bucket. " +
+					"(Requires access_key and secret_key)",
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJSONDiffs,
+			},
 			"cert": {
 				Type:        schema.TypeList,
 				Description: "The cert used by this Object Storage Bucket.",
@@ -195,10 +223,11 @@ func resourceLinodeObjectStorageBucketRead(d *schema.ResourceData, meta interfac
 
 	_, versioningPresent := d.GetOk("versioning")
 	_, lifecyclePresent := d.GetOk("lifecycle_rule")
+	_, policyPresent := d.GetOk("policy")
 
-	if versioningPresent || lifecyclePresent {
+	if versioningPresent || lifecyclePresent || policyPresent {
 		if accessKey == "" || secretKey == "" {
-			return fmt.Errorf("access_key and secret_key are required to get versioning and lifecycle info")
+			return fmt.Errorf("access_key and secret_key are required to get versioning, lifecycle, and policy info")
 		}
 
 		conn := s3ConnFromResourceData(d)
@@ -210,10 +239,20 @@ func resourceLinodeObjectStorageBucketRead(d *schema.ResourceData, meta interfac
 		if err := readLinodeObjectStorageBucketVersioning(d, conn); err != nil {
 			return fmt.Errorf("failed to find get object storage bucket versioning: %s", err)
 		}
+
+		if err := readLinodeObjectStorageBucketPolicy(d, conn); err != nil {
+			return fmt.Errorf("failed to find get object storage bucket policy: %s", err)
+		}
+	}
+
+	objectCluster, err := client.GetObjectStorageCluster(context.Background(), bucket.Cluster)
+	if err != nil {
+		return fmt.Errorf("failed to find the cluster of the specified Linode ObjectStorageBucket: %s", err)
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", bucket.Cluster, bucket.Label))
 	d.Set("cluster", bucket.Cluster)
+	d.Set("region", objectCluster.Region)
 	d.Set("label", bucket.Label)
 	d.Set("acl", access.ACL)
 	d.Set("cors_enabled", access.CorsEnabled)
@@ -229,6 +268,17 @@ func resourceLinodeObjectStorageBucketCreate(d *schema.ResourceData, meta interf
 	acl := d.Get("acl").(string)
 	corsEnabled := d.Get("cors_enabled").(bool)
 
+	if cluster == "" {
+		region := d.Get("region").(string)
+		objectCluster, err := getLinodeObjectStorageClusterByRegion(context.Background(), client, region)
+		if err != nil {
+			return err
+		}
+
+		cluster = objectCluster.ID
+		d.Set("cluster", cluster)
+	}
+
 	createOpts := linodego.ObjectStorageBucketCreateOptions{
 		Cluster:     cluster,
 		Label:       label,
@@ -246,6 +296,23 @@ func resourceLinodeObjectStorageBucketCreate(d *schema.ResourceData, meta interf
 	return resourceLinodeObjectStorageBucketUpdate(d, meta)
 }
 
+// getLinodeObjectStorageClusterByRegion resolves the Object Storage cluster that serves a region.
+func getLinodeObjectStorageClusterByRegion(
+	ctx context.Context, client linodego.Client, region string,
+) (*linodego.ObjectStorageCluster, error) {
+	clusters, err := client.ListObjectStorageClusters(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Linode Object Storage clusters: %s", err)
+	}
+
+	for _, cluster := range clusters {
+		if cluster.Region == region {
+			return &cluster, nil
+		}
+	}
+	return nil, fmt.Errorf("no Linode Object Storage cluster is available in region %s", region)
+}
+
 func resourceLinodeObjectStorageBucketUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ProviderMeta).Client
 
@@ -268,10 +335,11 @@ func resourceLinodeObjectStorageBucketUpdate(d *schema.ResourceData, meta interf
 
 	versioningChanged := d.HasChange("versioning")
 	lifecycleChanged := d.HasChange("lifecycle_rule")
+	policyChanged := d.HasChange("policy")
 
-	if versioningChanged || lifecycleChanged {
+	if versioningChanged || lifecycleChanged || policyChanged {
 		if accessKey == "" || secretKey == "" {
-			return fmt.Errorf("access_key and secret_key are required to set versioning and lifecycle info")
+			return fmt.Errorf("access_key and secret_key are required to set versioning, lifecycle, and policy info")
 		}
 
 		// Ensure we only update what is changed
@@ -286,6 +354,12 @@ func resourceLinodeObjectStorageBucketUpdate(d *schema.ResourceData, meta interf
 				return err
 			}
 		}
+
+		if policyChanged {
+			if err := updateLinodeObjectStorageBucketPolicy(d, conn); err != nil {
+				return err
+			}
+		}
 	}
 
 	return resourceLinodeObjectStorageBucketRead(d, meta)
@@ -297,6 +371,17 @@ func resourceLinodeObjectStorageBucketDelete(d *schema.ResourceData, meta interf
 	if err != nil {
 		return fmt.Errorf("Error parsing Linode ObjectStorageBucket id %s", d.Id())
 	}
+
+	if d.Get("force_destroy").(bool) {
+		if d.Get("access_key").(string) == "" || d.Get("secret_key").(string) == "" {
+			return fmt.Errorf("access_key and secret_key are required to force destroy a bucket")
+		}
+
+		if err := emptyLinodeObjectStorageBucket(s3ConnFromResourceData(d), label); err != nil {
+			return err
+		}
+	}
+
 	err = client.DeleteObjectStorageBucket(context.Background(), cluster, label)
 	if err != nil {
 		return fmt.Errorf("Error deleting Linode ObjectStorageBucket %s: %s", d.Id(), err)
@@ -304,6 +389,46 @@ func resourceLinodeObjectStorageBucketDelete(d *schema.ResourceData, meta interf
 	return nil
 }
 
+// emptyLinodeObjectStorageBucket deletes every object version and delete marker in the bucket. Objects
+// of buckets without versioning are listed as a single version, so this empties those as well.
+func emptyLinodeObjectStorageBucket(conn *s3.S3, bucket string) error {
+	var deleteErr error
+	err := conn.ListObjectVersionsPages(&s3.ListObjectVersionsInput{Bucket: &bucket},
+		func(page *s3.ListObjectVersionsOutput, lastPage bool) bool {
+			objects := make([]*s3.ObjectIdentifier, 0, len(page.Versions)+len(page.DeleteMarkers))
+			for _, version := range page.Versions {
+				objects = append(objects, &s3.ObjectIdentifier{Key: version.Key, VersionId: version.VersionId})
+			}
+			for _, marker := range page.DeleteMarkers {
+				objects = append(objects, &s3.ObjectIdentifier{Key: marker.Key, VersionId: marker.VersionId})
+			}
+			if len(objects) == 0 {
+				return true
+			}
+
+			out, err := conn.DeleteObjects(&s3.DeleteObjectsInput{
+				Bucket: &bucket,
+				Delete: &s3.Delete{Objects: objects},
+			})
+			if err != nil {
+				deleteErr = err
+				return false
+			}
+			if len(out.Errors) > 0 {
+				deleteErr = fmt.Errorf("%s: %s", aws.StringValue(out.Errors[0].Key), aws.StringValue(out.Errors[0].Message))
+				return false
+			}
+			return true
+		})
+	if err != nil {
+		return fmt.Errorf("failed to list objects of bucket %s: %s", bucket, err)
+	}
+	if deleteErr != nil {
+		return fmt.Errorf("failed to delete objects of bucket %s: %s", bucket, deleteErr)
+	}
+	return nil
+}
+
 func readLinodeObjectStorageBucketVersioning(d *schema.ResourceData, conn *s3.S3) error {
 	label := d.Get("label").(string)
 
@@ -338,6 +463,25 @@ func readLinodeObjectStorageBucketLifecycle(d *schema.ResourceData, conn *s3.S3)
 	return nil
 }
 
+func readLinodeObjectStorageBucketPolicy(d *schema.ResourceData, conn *s3.S3) error {
+	label := d.Get("label").(string)
+
+	policyOutput, err := conn.GetBucketPolicy(&s3.GetBucketPolicyInput{Bucket: &label})
+
+	// A "NoSuchBucketPolicy" error means that the bucket has no policy
+	if err != nil {
+		if err, ok := err.(awserr.Error); ok && err.Code() == "NoSuchBucketPolicy" {
+			d.Set("policy", "")
+			return nil
+		}
+		return fmt.Errorf("failed to get policy for bucket id %s: %s", d.Id(), err)
+	}
+
+	d.Set("policy", aws.StringValue(policyOutput.Policy))
+
+	return nil
+}
+
 func updateLinodeObjectStorageBucketVersioning(d *schema.ResourceData, conn *s3.S3) error {
 	bucket := d.Get("label").(string)
 	n := d.Get("versioning").(bool)
@@ -380,6 +524,37 @@ func updateLinodeObjectStorageBucketLifecycle(d *schema.ResourceData, conn *s3.S
 	return err
 }
 
+func updateLinodeObjectStorageBucketPolicy(d *schema.ResourceData, conn *s3.S3) error {
+	bucket := d.Get("label").(string)
+	policy := d.Get("policy").(string)
+
+	if policy == "" {
+		if _, err := conn.DeleteBucketPolicy(&s3.DeleteBucketPolicyInput{Bucket: &bucket}); err != nil {
+			return fmt.Errorf("failed to delete policy for bucket %s: %s", bucket, err)
+		}
+		return nil
+	}
+
+	if _, err := conn.PutBucketPolicy(&s3.PutBucketPolicyInput{Bucket: &bucket, Policy: &policy}); err != nil {
+		return fmt.Errorf("failed to put policy for bucket %s: %s", bucket, err)
+	}
+
+	return nil
+}
+
+// suppressEquivalentJSONDiffs suppresses the diff between two JSON documents that only differ
+// in whitespace or key order.
+func suppressEquivalentJSONDiffs(k, old, new string, d *schema.ResourceData) bool {
+	var oldValue, newValue interface{}
+	if err := json.Unmarshal([]byte(old), &oldValue); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &newValue); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(oldValue, newValue)
+}
+
 func updateLinodeObjectStorageBucketAccess(d *schema.ResourceData, client linodego.Client) error {
 	cluster := d.Get("cluster").(string)
 	label := d.Get("label").(string)
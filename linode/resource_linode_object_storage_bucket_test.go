@@ -169,6 +169,34 @@ func TestAccLinodeObjectStorageBucket_basic(t *testing.T) {
 	})
 }
 
+func TestAccLinodeObjectStorageBucket_region(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_object_storage_bucket.foobar"
+	var objectStorageBucketName = acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeObjectStorageBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckLinodeObjectStorageBucketConfigWithRegion(objectStorageBucketName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeObjectStorageBucketExists,
+					resource.TestCheckResourceAttr(resName, "region", "us-east"),
+					resource.TestCheckResourceAttr(resName, "cluster", "us-east-1"),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccLinodeObjectStorageBucket_access(t *testing.T) {
 	t.Parallel()
 
@@ -234,6 +262,80 @@ func TestAccLinodeObjectStorageBucket_versioning(t *testing.T) {
 	})
 }
 
+func TestSuppressEquivalentJSONDiffs(t *testing.T) {
+	for _, tc := range []struct {
+		old, new string
+		suppress bool
+	}{
+		{`{"a":1,"b":[1,2]}`, `{ "b": [1, 2], "a": 1 }`, true},
+		{`{"a":1}`, `{"a":2}`, false},
+		{`{"a":[1,2]}`, `{"a":[2,1]}`, false},
+		{``, `{"a":1}`, false},
+		{`{"a":1}`, `not json`, false},
+	} {
+		if suppress := suppressEquivalentJSONDiffs("policy", tc.old, tc.new, nil); suppress != tc.suppress {
+			t.Errorf("expected the diff from %q to %q to be suppressed: %t, got %t", tc.old, tc.new, tc.suppress, suppress)
+		}
+	}
+}
+
+func TestAccLinodeObjectStorageBucket_policy(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_object_storage_bucket.foobar"
+	objectStorageBucketName := acctest.RandomWithPrefix("tf-test")
+	objectStorageKeyName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeObjectStorageBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckLinodeObjectStorageBucketConfigWithPolicy(objectStorageBucketName, objectStorageKeyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeObjectStorageBucketExists,
+					resource.TestMatchResourceAttr(resName, "policy", regexp.MustCompile(`s3:GetObject`)),
+				),
+			},
+			{
+				Config: testAccCheckLinodeObjectStorageBucketConfigWithVersioning(
+					objectStorageBucketName, objectStorageKeyName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeObjectStorageBucketExists,
+					resource.TestCheckResourceAttr(resName, "policy", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLinodeObjectStorageBucket_forceDestroy(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_object_storage_bucket.foobar"
+	objectStorageBucketName := acctest.RandomWithPrefix("tf-test")
+	objectStorageKeyName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeObjectStorageBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckLinodeObjectStorageBucketConfigWithForceDestroy(
+					objectStorageBucketName, objectStorageKeyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeObjectStorageBucketExists,
+					resource.TestCheckResourceAttr(resName, "force_destroy", "true"),
+					// write two versions of an object and delete it to leave a delete marker behind
+					testAccCheckLinodeObjectStorageBucketPutObjects(resName, "test", 2, true),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLinodeObjectStorageBucket_lifecycle(t *testing.T) {
 	t.Parallel()
 
@@ -439,6 +541,40 @@ func testAccCheckLinodeObjectStorageBucketHasSSL(expected bool) func(*terraform.
 	}
 }
 
+func testAccCheckLinodeObjectStorageBucketPutObjects(
+	name, key string, versions int, deleteMarker bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("could not find resource %s in root module", name)
+		}
+
+		bucket := rs.Primary.Attributes["label"]
+		conn := s3.New(session.New(&aws.Config{
+			Region:      aws.String("us-east-1"),
+			Credentials: credentials.NewStaticCredentials(rs.Primary.Attributes["access_key"], rs.Primary.Attributes["secret_key"], ""),
+			Endpoint:    aws.String(fmt.Sprintf(linodeObjectsEndpoint, rs.Primary.Attributes["cluster"])),
+		}))
+
+		for i := 0; i < versions; i++ {
+			if _, err := conn.PutObject(&s3.PutObjectInput{
+				Bucket: &bucket,
+				Key:    &key,
+				Body:   bytes.NewReader([]byte(fmt.Sprintf("version %d", i))),
+			}); err != nil {
+				return fmt.Errorf("failed to put Bucket (%s) Object (%s): %s", bucket, key, err)
+			}
+		}
+
+		if deleteMarker {
+			if _, err := conn.DeleteObject(&s3.DeleteObjectInput{Bucket: &bucket, Key: &key}); err != nil {
+				return fmt.Errorf("failed to delete Bucket (%s) Object (%s): %s", bucket, key, err)
+			}
+		}
+		return nil
+	}
+}
+
 func testAccCheckLinodeObjectStorageBucketDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*ProviderMeta).Client
 	for _, rs := range s.RootModule().Resources {
@@ -478,6 +614,14 @@ resource "linode_object_storage_bucket" "foobar" {
 }`, object_storage_bucket)
 }
 
+func testAccCheckLinodeObjectStorageBucketConfigWithRegion(object_storage_bucket string) string {
+	return fmt.Sprintf(`
+resource "linode_object_storage_bucket" "foobar" {
+	region = "us-east"
+	label = "%s"
+}`, object_storage_bucket)
+}
+
 func testAccCheckLinodeObjectStorageBucketConfigWithAccess(object_storage_bucket, acl string, cors bool) string {
 	return fmt.Sprintf(`
 resource "linode_object_storage_bucket" "foobar" {
@@ -519,6 +663,41 @@ resource "linode_object_storage_bucket" "foobar" {
 }`, bucketName, versioning)
 }
 
+func testAccCheckLinodeObjectStorageBucketConfigWithPolicy(bucketName, keyName string) string {
+	return testAccCheckLinodeObjectStorageKeyConfigBasic(keyName) + fmt.Sprintf(`
+resource "linode_object_storage_bucket" "foobar" {
+	access_key = linode_object_storage_key.foobar.access_key
+	secret_key = linode_object_storage_key.foobar.secret_key
+
+	cluster = "us-east-1"
+	label = "%[1]s"
+
+	policy = jsonencode({
+		Version = "2012-10-17"
+		Statement = [{
+			Effect = "Allow"
+			Principal = { AWS = ["*"] }
+			Action = ["s3:GetObject"]
+			Resource = ["arn:aws:s3:::%[1]s/public/*"]
+		}]
+	})
+}`, bucketName)
+}
+
+func testAccCheckLinodeObjectStorageBucketConfigWithForceDestroy(bucketName, keyName string) string {
+	return testAccCheckLinodeObjectStorageKeyConfigBasic(keyName) + fmt.Sprintf(`
+resource "linode_object_storage_bucket" "foobar" {
+	access_key = linode_object_storage_key.foobar.access_key
+	secret_key = linode_object_storage_key.foobar.secret_key
+
+	cluster = "us-east-1"
+	label = "%s"
+
+	versioning = true
+	force_destroy = true
+}`, bucketName)
+}
+
 func testAccCheckLinodeObjectStorageBucketConfigWithLifecycle(bucketName, keyName string) string {
 	return testAccCheckLinodeObjectStorageKeyConfigBasic(keyName) + fmt.Sprintf(`
 resource "linode_object_storage_bucket" "foobar" {
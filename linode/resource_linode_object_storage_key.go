@@ -3,6 +3,7 @@ package linode
 import (
 	"context"
 	"fmt"
+	"log"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -108,6 +109,11 @@ func resourceLinodeObjectStorageKeyRead(d *schema.ResourceData, meta interface{}
 
 	objectStorageKey, err := client.GetObjectStorageKey(context.Background(), int(id))
 	if err != nil {
+		if isLinodeNotFound(err) {
+			log.Printf("[WARN] removing Object Storage Key ID %q from state because it no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return fmt.Errorf("Error finding the specified Linode Object Storage Key: %s", err)
 	}
 
@@ -157,7 +163,7 @@ func resourceLinodeObjectStorageKeyDelete(d *schema.ResourceData, meta interface
 		return fmt.Errorf("Error parsing Linode Object Storage Key id %s as int", d.Id())
 	}
 	err = client.DeleteObjectStorageKey(context.Background(), int(id))
-	if err != nil {
+	if err != nil && !isLinodeNotFound(err) {
 		return fmt.Errorf("Error deleting Linode Object Storage Key %d: %s", id, err)
 	}
 	return nil
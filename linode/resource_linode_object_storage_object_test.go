@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"reflect"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -240,6 +242,91 @@ func TestAccLinodeObjectStorageObject_updates(t *testing.T) {
 	})
 }
 
+func TestHashLinodeObjectStorageObjectsSourceDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf-test-objs-source")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := os.MkdirAll(filepath.Join(dir, "css"), 0755); err != nil {
+		t.Fatalf("failed to create temp dir: %s", err)
+	}
+	for name, content := range map[string]string{"index.html": "hello", "css/site.css": ""} {
+		if err := ioutil.WriteFile(filepath.Join(dir, filepath.FromSlash(name)), []byte(content), 0600); err != nil {
+			t.Fatalf("failed to write temp file: %s", err)
+		}
+	}
+
+	files, err := hashLinodeObjectStorageObjectsSourceDir(dir, "site/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]string{
+		"site/index.html":   "5d41402abc4b2a76b9719d911017c592",
+		"site/css/site.css": "d41d8cd98f00b204e9800998ecf8427e",
+	}
+	if !reflect.DeepEqual(files, expected) {
+		t.Errorf("expected %v, got %v", expected, files)
+	}
+
+	if _, err := hashLinodeObjectStorageObjectsSourceDir(filepath.Join(dir, "missing"), ""); err == nil {
+		t.Error("expected an error for a missing source directory")
+	}
+}
+
+func TestAccLinodeObjectStorageObjects_sourceDir(t *testing.T) {
+	t.Parallel()
+
+	bucketName := acctest.RandomWithPrefix("tf-test")
+	keyName := acctest.RandomWithPrefix("tf_test")
+	resName := "linode_object_storage_objects.objects"
+
+	dir, err := ioutil.TempDir("", "tf-test-objs-source")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	writeFile := func(name, content string) {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0600); err != nil {
+			t.Fatalf("failed to write temp file: %s", err)
+		}
+	}
+	writeFile("index.html", "<h1>hello</h1>")
+	writeFile("app.js", "console.log('hello')")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeObjectStorageKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckLinodeObjectStorageObjectsConfigSourceDir(bucketName, keyName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "files.%", "2"),
+					resource.TestCheckResourceAttrSet(resName, "files.site/index.html"),
+					resource.TestCheckResourceAttrSet(resName, "files.site/app.js"),
+				),
+			},
+			{
+				PreConfig: func() {
+					writeFile("index.html", "<h1>updated</h1>")
+					if err := os.Remove(filepath.Join(dir, "app.js")); err != nil {
+						t.Fatalf("failed to remove temp file: %s", err)
+					}
+				},
+				Config: testAccCheckLinodeObjectStorageObjectsConfigSourceDir(bucketName, keyName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "files.%", "1"),
+					resource.TestCheckResourceAttrSet(resName, "files.site/index.html"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckLinodeObjectStorageObjectConfigBasic(name, keyName, content string) string {
 	return testAccCheckLinodeObjectStorageBucketConfigBasic(name) + testAccCheckLinodeObjectStorageKeyConfigBasic(keyName) + fmt.Sprintf(`
 resource "linode_object_storage_object" "object" {
@@ -252,6 +339,18 @@ resource "linode_object_storage_object" "object" {
 }`, content)
 }
 
+func testAccCheckLinodeObjectStorageObjectsConfigSourceDir(name, keyName, dir string) string {
+	return testAccCheckLinodeObjectStorageBucketConfigBasic(name) + testAccCheckLinodeObjectStorageKeyConfigBasic(keyName) + fmt.Sprintf(`
+resource "linode_object_storage_objects" "objects" {
+	bucket     = linode_object_storage_bucket.foobar.label
+	cluster    = "us-east-1"
+	access_key = linode_object_storage_key.foobar.access_key
+	secret_key = linode_object_storage_key.foobar.secret_key
+	source_dir = "%s"
+	key_prefix = "site/"
+}`, dir)
+}
+
 func testAccCheckLinodeObjectStorageObjectConfigBase64Encoded(name, keyName, content string) string {
 	return testAccCheckLinodeObjectStorageBucketConfigBasic(name) + testAccCheckLinodeObjectStorageKeyConfigBasic(keyName) + fmt.Sprintf(`
 resource "linode_object_storage_object" "object" {
@@ -0,0 +1,265 @@
+package linode
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceLinodeObjectStorageObjects() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLinodeObjectStorageObjectsCreate,
+		Read:   resourceLinodeObjectStorageObjectsRead,
+		Update: resourceLinodeObjectStorageObjectsUpdate,
+		Delete: resourceLinodeObjectStorageObjectsDelete,
+
+		CustomizeDiff: resourceLinodeObjectStorageObjectsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Description: "The target bucket to put the objects in.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"cluster": {
+				Type:        schema.TypeString,
+				Description: "The target cluster that the bucket is in.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"secret_key": {
+				Type:        schema.TypeString,
+				Description: "The S3 secret key with access to the target bucket.",
+				Required:    true,
+			},
+			"access_key": {
+				Type:        schema.TypeString,
+				Description: "The S3 access key with access to the target bucket.",
+				Required:    true,
+			},
+			"source_dir": {
+				Type:        schema.TypeString,
+				Description: "The directory whose files are uploaded, keyed by their path relative to it.",
+				Required:    true,
+			},
+			"key_prefix": {
+				Type:        schema.TypeString,
+				Description: "A prefix prepended to the key of every uploaded object.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"acl": {
+				Type:        schema.TypeString,
+				Description: "The ACL config given to the objects.",
+				Default:     s3.ObjectCannedACLPrivate,
+				Optional:    true,
+			},
+			"files": {
+				Type:        schema.TypeMap,
+				Description: "The keys of the uploaded objects mapped to the MD5 hashes of their content.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceLinodeObjectStorageObjectsCreate(d *schema.ResourceData, meta interface{}) error {
+	files, err := hashLinodeObjectStorageObjectsSourceDir(d.Get("source_dir").(string), d.Get("key_prefix").(string))
+	if err != nil {
+		return err
+	}
+
+	conn := s3ConnFromResourceData(d)
+	for key := range files {
+		if err := putLinodeObjectStorageObjectsFile(d, conn, key); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:%s", d.Get("cluster"), d.Get("bucket"), d.Get("key_prefix")))
+	d.Set("files", files)
+
+	return resourceLinodeObjectStorageObjectsRead(d, meta)
+}
+
+func resourceLinodeObjectStorageObjectsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := s3ConnFromResourceData(d)
+	bucket := d.Get("bucket").(string)
+
+	// Only the objects uploaded by this resource are tracked, so other objects in the bucket are left alone.
+	files := make(map[string]string)
+	for key := range d.Get("files").(map[string]interface{}) {
+		key := key
+		headOutput, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: &bucket,
+			Key:    &key,
+		})
+		if err != nil {
+			if awsErr, ok := err.(awserr.RequestFailure); ok && awsErr.StatusCode() == http.StatusNotFound {
+				log.Printf("[WARN] could not find Bucket (%s) Object (%s)", bucket, key)
+				continue
+			}
+			return fmt.Errorf("failed to get Bucket (%s) Object (%s): %s", bucket, key, err)
+		}
+		files[key] = strings.Trim(aws.StringValue(headOutput.ETag), `"`)
+	}
+
+	d.Set("files", files)
+	return nil
+}
+
+func resourceLinodeObjectStorageObjectsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := s3ConnFromResourceData(d)
+	bucket := d.Get("bucket").(string)
+
+	oldFiles, _ := d.GetChange("files")
+	oldHashes := oldFiles.(map[string]interface{})
+
+	// The planned files may be unknown, e.g. when source_dir is computed, so the directory is hashed again.
+	files, err := hashLinodeObjectStorageObjectsSourceDir(d.Get("source_dir").(string), d.Get("key_prefix").(string))
+	if err != nil {
+		return err
+	}
+
+	aclChanged := d.HasChange("acl")
+	for key, hash := range files {
+		if oldHash, ok := oldHashes[key]; ok && oldHash == hash && !aclChanged {
+			continue
+		}
+		if err := putLinodeObjectStorageObjectsFile(d, conn, key); err != nil {
+			return err
+		}
+	}
+
+	for key := range oldHashes {
+		if _, ok := files[key]; ok {
+			continue
+		}
+		if err := deleteLinodeObjectStorageObject(conn, bucket, key, "", false); err != nil {
+			return err
+		}
+	}
+
+	d.Set("files", files)
+	return resourceLinodeObjectStorageObjectsRead(d, meta)
+}
+
+func resourceLinodeObjectStorageObjectsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := s3ConnFromResourceData(d)
+	bucket := d.Get("bucket").(string)
+
+	for key := range d.Get("files").(map[string]interface{}) {
+		if err := deleteLinodeObjectStorageObject(conn, bucket, key, "", false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resourceLinodeObjectStorageObjectsCustomizeDiff plans an update whenever a file in the source directory
+// is added, removed, or changed, since those changes are not visible in the configuration itself.
+func resourceLinodeObjectStorageObjectsCustomizeDiff(
+	ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source_dir") || !d.NewValueKnown("key_prefix") {
+		return d.SetNewComputed("files")
+	}
+
+	files, err := hashLinodeObjectStorageObjectsSourceDir(d.Get("source_dir").(string), d.Get("key_prefix").(string))
+	if err != nil {
+		return err
+	}
+
+	current := make(map[string]string)
+	for key, hash := range d.Get("files").(map[string]interface{}) {
+		current[key] = hash.(string)
+	}
+	if d.Id() != "" && reflect.DeepEqual(current, files) {
+		return nil
+	}
+	return d.SetNew("files", files)
+}
+
+// hashLinodeObjectStorageObjectsSourceDir maps the key of every file under dir, made of prefix and the
+// slash-separated path of the file relative to dir, to the MD5 hash of its content. The hash matches the
+// ETag that Object Storage reports for objects uploaded in a single part.
+func hashLinodeObjectStorageObjectsSourceDir(dir, prefix string) (map[string]string, error) {
+	files := make(map[string]string)
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		// Object Storage reports the MD5 hash as the ETag, it is not used for security
+		hash := md5.New() //nolint:gosec
+		if _, err := io.Copy(hash, file); err != nil {
+			return err
+		}
+
+		files[prefix+filepath.ToSlash(rel)] = hex.EncodeToString(hash.Sum(nil))
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read source directory %s: %s", dir, err)
+	}
+	return files, nil
+}
+
+// putLinodeObjectStorageObjectsFile uploads the file of the source directory that maps to key, setting its
+// content type from the file extension.
+func putLinodeObjectStorageObjectsFile(d *schema.ResourceData, conn *s3.S3, key string) error {
+	bucket := d.Get("bucket").(string)
+	acl := d.Get("acl").(string)
+	rel := strings.TrimPrefix(key, d.Get("key_prefix").(string))
+	path := filepath.Join(d.Get("source_dir").(string), filepath.FromSlash(rel))
+
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %s", path, err)
+	}
+	defer file.Close()
+
+	putInput := &s3.PutObjectInput{
+		Bucket: &bucket,
+		Key:    &key,
+		Body:   file,
+		ACL:    &acl,
+	}
+	if contentType := mime.TypeByExtension(filepath.Ext(path)); contentType != "" {
+		putInput.ContentType = &contentType
+	}
+
+	if _, err := conn.PutObject(putInput); err != nil {
+		return fmt.Errorf("failed to put Bucket (%s) Object (%s): %s", bucket, key, err)
+	}
+	return nil
+}
@@ -48,7 +48,7 @@ func resourceLinodeRDNSRead(d *schema.ResourceData, meta interface{}) error {
 
 	ip, err := client.GetIPAddress(context.Background(), ipStr)
 	if err != nil {
-		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+		if isLinodeNotFound(err) {
 			log.Printf("[WARN] removing Linode RDNS %q from state because it no longer exists", ipStr)
 			d.SetId("")
 			return nil
@@ -123,7 +123,7 @@ func resourceLinodeRDNSDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if _, err := client.UpdateIPAddress(context.Background(), d.Id(), updateOpts); err != nil {
-		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+		if isLinodeNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -0,0 +1,156 @@
+package linode
+
+import (
+	"context"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/linode/linodego"
+)
+
+func resourceLinodeRDNSSetRecord() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"address": {
+				Type:         schema.TypeString,
+				Description:  "The public Linode IPv4 or IPv6 address to operate on.",
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"rdns": {
+				Type:         schema.TypeString,
+				Description:  "The reverse DNS assigned to this address.",
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(3, 254),
+			},
+		},
+	}
+}
+
+func resourceLinodeRDNSSet() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLinodeRDNSSetCreate,
+		ReadContext:   resourceLinodeRDNSSetRead,
+		UpdateContext: resourceLinodeRDNSSetUpdate,
+		DeleteContext: resourceLinodeRDNSSetDelete,
+		Schema: map[string]*schema.Schema{
+			"record": {
+				Type:        schema.TypeSet,
+				Description: "The reverse DNS of each address to manage.",
+				Required:    true,
+				Elem:        resourceLinodeRDNSSetRecord(),
+			},
+		},
+	}
+}
+
+func resourceLinodeRDNSSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+
+	records := []map[string]interface{}{}
+	for _, address := range getLinodeRDNSSetAddresses(d.Get("record").(*schema.Set)) {
+		ip, err := client.GetIPAddress(ctx, address)
+		if err != nil {
+			if isLinodeNotFound(err) {
+				log.Printf("[WARN] removing Linode RDNS %q from state because it no longer exists", address)
+				continue
+			}
+			return diag.Errorf("Error finding the Linode RDNS of %s: %s", address, err)
+		}
+
+		records = append(records, map[string]interface{}{
+			"address": address,
+			"rdns":    ip.RDNS,
+		})
+	}
+
+	d.Set("record", records)
+	return nil
+}
+
+func resourceLinodeRDNSSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	records := d.Get("record").(*schema.Set)
+
+	d.SetId(strings.Join(getLinodeRDNSSetAddresses(records), ","))
+
+	diags := updateLinodeRDNSSetRecords(ctx, client, records.List())
+	return append(diags, resourceLinodeRDNSSetRead(ctx, d, meta)...)
+}
+
+func resourceLinodeRDNSSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+
+	oldRecords, newRecords := d.GetChange("record")
+	newAddresses := make(map[string]bool)
+	for _, address := range getLinodeRDNSSetAddresses(newRecords.(*schema.Set)) {
+		newAddresses[address] = true
+	}
+
+	var removed []string
+	for _, address := range getLinodeRDNSSetAddresses(oldRecords.(*schema.Set)) {
+		if !newAddresses[address] {
+			removed = append(removed, address)
+		}
+	}
+
+	diags := resetLinodeRDNSSetAddresses(ctx, client, removed)
+	diags = append(diags, updateLinodeRDNSSetRecords(
+		ctx, client, newRecords.(*schema.Set).Difference(oldRecords.(*schema.Set)).List())...)
+	return append(diags, resourceLinodeRDNSSetRead(ctx, d, meta)...)
+}
+
+func resourceLinodeRDNSSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+
+	diags := resetLinodeRDNSSetAddresses(ctx, client, getLinodeRDNSSetAddresses(d.Get("record").(*schema.Set)))
+	if !diags.HasError() {
+		d.SetId("")
+	}
+	return diags
+}
+
+// updateLinodeRDNSSetRecords sets the reverse DNS of each record. Every record is attempted, and an
+// error is reported for each address that could not be updated.
+func updateLinodeRDNSSetRecords(ctx context.Context, client linodego.Client, records []interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, record := range records {
+		record := record.(map[string]interface{})
+		address := record["address"].(string)
+		rdns := record["rdns"].(string)
+
+		if _, err := client.UpdateIPAddress(ctx, address, linodego.IPAddressUpdateOptions{RDNS: &rdns}); err != nil {
+			diags = append(diags, diag.Errorf("Error updating the Linode RDNS of %s: %s", address, err)...)
+		}
+	}
+	return diags
+}
+
+// resetLinodeRDNSSetAddresses resets the reverse DNS of each address to the default provided by
+// Linode. Every address is attempted, and an error is reported for each address that could not be reset.
+func resetLinodeRDNSSetAddresses(ctx context.Context, client linodego.Client, addresses []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, address := range addresses {
+		if _, err := client.UpdateIPAddress(ctx, address, linodego.IPAddressUpdateOptions{RDNS: nil}); err != nil {
+			if isLinodeNotFound(err) {
+				continue
+			}
+			diags = append(diags, diag.Errorf("Error resetting the Linode RDNS of %s: %s", address, err)...)
+		}
+	}
+	return diags
+}
+
+// getLinodeRDNSSetAddresses returns the sorted addresses of the given records.
+func getLinodeRDNSSetAddresses(records *schema.Set) []string {
+	addresses := make([]string, 0, records.Len())
+	for _, record := range records.List() {
+		addresses = append(addresses, record.(map[string]interface{})["address"].(string))
+	}
+	sort.Strings(addresses)
+	return addresses
+}
@@ -0,0 +1,98 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccLinodeRDNSSet_basic(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_rdns_set.foobar"
+	label := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeRDNSSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckLinodeRDNSSetBasic(label, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "record.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckLinodeRDNSSetBasic(label, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "record.#", "1"),
+					resource.TestMatchResourceAttr(
+						"data.linode_networking_ip.foobar.1", "rdns", regexp.MustCompile(`.members.linode.com$`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLinodeRDNSSetDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ProviderMeta).Client
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "linode_rdns_set" {
+			continue
+		}
+
+		for _, address := range strings.Split(rs.Primary.ID, ",") {
+			ip, err := client.GetIPAddress(context.Background(), address)
+			if err != nil {
+				if isLinodeNotFound(err) {
+					continue
+				}
+				return fmt.Errorf("Error retrieving the RDNS of %s: %s", address, err)
+			}
+
+			if strings.HasSuffix(ip.RDNS, ".nip.io") {
+				return fmt.Errorf("Linode RDNS of %s was not reset: %s", address, ip.RDNS)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLinodeRDNSSetBasic(label string, records int) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	count = 2
+
+	label = "%s-${count.index}"
+	group = "tf_test"
+	image = "linode/alpine3.12"
+	type = "g6-standard-1"
+	region = "us-east"
+}
+
+resource "linode_rdns_set" "foobar" {
+	dynamic "record" {
+		for_each = slice(linode_instance.foobar, 0, %d)
+
+		content {
+			address = record.value.ip_address
+			rdns = "${record.value.ip_address}.nip.io"
+		}
+	}
+}
+
+data "linode_networking_ip" "foobar" {
+	count = 2
+
+	address = linode_instance.foobar[count.index].ip_address
+	depends_on = [linode_rdns_set.foobar]
+}`, label, records)
+}
@@ -19,6 +19,7 @@ func resourceLinodeStackscript() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceLinodeStackscriptCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"label": {
 				Type:        schema.TypeString,
@@ -52,7 +53,7 @@ func resourceLinodeStackscript() *schema.Resource {
 				Type: schema.TypeList,
 				Elem: &schema.Schema{Type: schema.TypeString},
 				Description: "An array of Image IDs representing the Images that this StackScript is compatible for " +
-					"deploying with.",
+					"deploying with. Use \"any/all\" to allow any Image.",
 				Required: true,
 			},
 
@@ -142,7 +143,7 @@ func resourceLinodeStackscriptRead(d *schema.ResourceData, meta interface{}) err
 
 	stackscript, err := client.GetStackscript(context.Background(), int(id))
 	if err != nil {
-		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+		if isLinodeNotFound(err) {
 			log.Printf("[WARN] removing StackScript ID %q from state because it no longer exists", d.Id())
 			d.SetId("")
 			return nil
@@ -227,10 +228,52 @@ func resourceLinodeStackscriptDelete(d *schema.ResourceData, meta interface{}) e
 	}
 	err = client.DeleteStackscript(context.Background(), int(id))
 	if err != nil {
-		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+		if isLinodeNotFound(err) {
 			return nil
 		}
 		return fmt.Errorf("Error deleting Linode Stackscript %d: %s", id, err)
 	}
 	return nil
 }
+
+// linodeStackscriptAnyImage is the wildcard that makes a StackScript compatible with every Image.
+const linodeStackscriptAnyImage = "any/all"
+
+// resourceLinodeStackscriptCustomizeDiff ensures that every Image a StackScript declares exists.
+// Deprecated Images are allowed, but logged as they may be removed.
+func resourceLinodeStackscriptCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("images") || !d.NewValueKnown("images") {
+		return nil
+	}
+
+	images := expandStringList(d.Get("images").([]interface{}))
+	if len(images) == 0 || (len(images) == 1 && images[0] == linodeStackscriptAnyImage) {
+		return nil
+	}
+
+	client := meta.(*ProviderMeta).Client
+	available, err := client.ListImages(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("Error listing Images to validate StackScript images: %s", err)
+	}
+
+	deprecated := make(map[string]bool, len(available))
+	for _, image := range available {
+		deprecated[image.ID] = image.Deprecated
+	}
+
+	for _, image := range images {
+		if image == linodeStackscriptAnyImage {
+			continue
+		}
+
+		isDeprecated, ok := deprecated[image]
+		if !ok {
+			return fmt.Errorf("image %q is not an available Image ID or %q", image, linodeStackscriptAnyImage)
+		}
+		if isDeprecated {
+			log.Printf("[WARN] StackScript image %q is deprecated and may be removed", image)
+		}
+	}
+	return nil
+}
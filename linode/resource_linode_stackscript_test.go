@@ -3,6 +3,7 @@ package linode
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -162,6 +163,32 @@ func TestAccLinodeStackscript_codeChange(t *testing.T) {
 	})
 }
 
+func TestAccLinodeStackscript_images(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_stackscript.foobar"
+	var stackscriptName = acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeStackscriptDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckLinodeStackscriptWithImage(stackscriptName, "linode/not-an-image"),
+				ExpectError: regexp.MustCompile(`image "linode/not-an-image" is not an available Image ID`),
+			},
+			{
+				Config: testAccCheckLinodeStackscriptWithImage(stackscriptName, "any/all"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeStackscriptExists,
+					resource.TestCheckResourceAttr(resName, "images.0", "any/all"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckLinodeStackscriptExists(s *terraform.State) error {
 	client := testAccProvider.Meta().(*ProviderMeta).Client
 
@@ -228,6 +255,20 @@ EOF
 }`, stackscript)
 }
 
+func testAccCheckLinodeStackscriptWithImage(stackscript, image string) string {
+	return fmt.Sprintf(`
+resource "linode_stackscript" "foobar" {
+	label = "%s"
+	script = <<EOF
+#!/bin/bash
+echo hello
+EOF
+	images = ["%s"]
+	description = "tf_test stackscript"
+	rev_note = "initial"
+}`, stackscript, image)
+}
+
 func testAccCheckLinodeStackscriptBasicRenamed(stackscript string) string {
 	return fmt.Sprintf(`
 resource "linode_stackscript" "foobar" {
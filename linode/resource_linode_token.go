@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/linode/linodego"
 )
 
@@ -44,6 +45,21 @@ func resourceLinodeToken() *schema.Resource {
 				ForceNew:         true,
 				DiffSuppressFunc: equivalentDate,
 			},
+			"rotation_id": {
+				Type: schema.TypeString,
+				Description: "An arbitrary value that, when changed, replaces this token with a new one. Use it with " +
+					"the create_before_destroy lifecycle to rotate the token without downtime.",
+				Optional: true,
+				ForceNew: true,
+			},
+			"rotation_grace_period": {
+				Type: schema.TypeInt,
+				Description: "The number of seconds to wait before revoking this token when it is replaced or " +
+					"destroyed, giving its consumers time to pick up the new token.",
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 			"created": {
 				Type:        schema.TypeString,
 				Description: "The date and time this token was created.",
@@ -164,6 +180,12 @@ func resourceLinodeTokenDelete(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return fmt.Errorf("Error parsing Linode Token id %s as int", d.Id())
 	}
+
+	if gracePeriod := d.Get("rotation_grace_period").(int); gracePeriod > 0 {
+		log.Printf("[INFO] Waiting %d seconds before revoking Linode Token %d", gracePeriod, id)
+		time.Sleep(time.Duration(gracePeriod) * time.Second)
+	}
+
 	err = client.DeleteToken(context.Background(), int(id))
 	if err != nil {
 		return fmt.Errorf("Error deleting Linode Token %d: %s", id, err)
@@ -69,7 +69,7 @@ func TestAccLinodeToken_basic(t *testing.T) {
 				ResourceName:            resName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"token"},
+				ImportStateVerifyIgnore: []string{"token", "rotation_grace_period"},
 			},
 			{
 				Config: testAccCheckLinodeTokenConfigUpdates(tokenName),
@@ -82,6 +82,56 @@ func TestAccLinodeToken_basic(t *testing.T) {
 	})
 }
 
+func TestAccLinodeToken_rotation(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_token.foobar"
+	var tokenName = acctest.RandomWithPrefix("tf_test")
+	var firstID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeTokenDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckLinodeTokenConfigRotation(tokenName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeTokenExists,
+					resource.TestCheckResourceAttr(resName, "rotation_id", "first"),
+					func(s *terraform.State) error {
+						firstID = s.RootModule().Resources[resName].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccCheckLinodeTokenConfigRotation(tokenName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeTokenExists,
+					resource.TestCheckResourceAttr(resName, "rotation_id", "second"),
+					resource.TestCheckResourceAttrSet(resName, "token"),
+					func(s *terraform.State) error {
+						if s.RootModule().Resources[resName].Primary.ID == firstID {
+							return fmt.Errorf("expected Token %s to be replaced", firstID)
+						}
+
+						id, err := strconv.Atoi(firstID)
+						if err != nil {
+							return err
+						}
+						client := testAccProvider.Meta().(*ProviderMeta).Client
+						if _, err := client.GetToken(context.Background(), id); err == nil {
+							return fmt.Errorf("expected the replaced Token %d to be revoked", id)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckLinodeTokenExists(s *terraform.State) error {
 	client := testAccProvider.Meta().(*ProviderMeta).Client
 
@@ -151,3 +201,18 @@ func testAccCheckLinodeTokenConfigUpdates(token string) string {
 		expiry = "2100-01-02T03:04:05Z"
 	}`, token)
 }
+
+func testAccCheckLinodeTokenConfigRotation(token, rotationID string) string {
+	return fmt.Sprintf(`
+	resource "linode_token" "foobar" {
+		label = "%s"
+		scopes = "linodes:read_only"
+		expiry = "2100-01-02T03:04:05Z"
+		rotation_id = "%s"
+		rotation_grace_period = 5
+
+		lifecycle {
+			create_before_destroy = true
+		}
+	}`, token, rotationID)
+}
@@ -17,6 +17,9 @@ const (
 	LinodeVolumeDeleteTimeout = 10 * time.Minute
 )
 
+// entityVolume is the event entity type of Volumes, which linodego does not define.
+const entityVolume linodego.EntityType = "volume"
+
 func resourceLinodeVolume() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceLinodeVolumeCreate,
@@ -26,6 +29,7 @@ func resourceLinodeVolume() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceLinodeVolumeCustomizeDiff,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(LinodeVolumeCreateTimeout),
 			Update: schema.DefaultTimeout(LinodeVolumeUpdateTimeout),
@@ -55,6 +59,13 @@ func resourceLinodeVolume() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+			"source_volume_id": {
+				Type: schema.TypeInt,
+				Description: "The ID of a Volume to clone into this Volume. Volumes can only be cloned within " +
+					"the same region.",
+				Optional: true,
+				ForceNew: true,
+			},
 			"linode_id": {
 				Type:        schema.TypeInt,
 				Description: "The Linode ID where the Volume should be attached.",
@@ -84,9 +95,13 @@ func resourceLinodeVolumeRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error parsing Linode Volume ID %s as int: %s", d.Id(), err)
 	}
 
-	volume, err := client.GetVolume(context.Background(), int(id))
+	var volume *linodego.Volume
+	err = retryOnNotFoundIfNew(context.Background(), d, func(ctx context.Context) (err error) {
+		volume, err = client.GetVolume(ctx, int(id))
+		return err
+	})
 	if err != nil {
-		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+		if isLinodeNotFound(err) && !d.IsNewResource() {
 			log.Printf("[WARN] removing Volume ID %q from state because it no longer exists", d.Id())
 			d.SetId("")
 			return nil
@@ -106,7 +121,11 @@ func resourceLinodeVolumeRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceLinodeVolumeCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ProviderMeta).Client
+	client := getVolumeClient(meta)
+
+	if _, ok := d.GetOk("source_volume_id"); ok {
+		return resourceLinodeVolumeCreateClone(d, meta)
+	}
 
 	var linodeID *int
 
@@ -143,17 +162,90 @@ func resourceLinodeVolumeCreate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	if _, err = client.WaitForVolumeStatus(
-		context.Background(), volume.ID, linodego.VolumeActive, int(d.Timeout(schema.TimeoutCreate).Seconds()),
-	); err != nil {
-		return err
+	if !meta.(*ProviderMeta).Config.SkipVolumeReadyPoll {
+		if _, err := client.WaitForEventFinished(
+			context.Background(), volume.ID, entityVolume, linodego.ActionVolumeCreate,
+			*volume.Created, int(d.Timeout(schema.TimeoutCreate).Seconds()),
+		); err != nil {
+			return fmt.Errorf("Error waiting for Linode Volume %d to finish creating: %s", volume.ID, err)
+		}
+
+		if _, err = client.WaitForVolumeStatus(
+			context.Background(), volume.ID, linodego.VolumeActive, int(d.Timeout(schema.TimeoutCreate).Seconds()),
+		); err != nil {
+			return err
+		}
+	}
+
+	return resourceLinodeVolumeRead(d, meta)
+}
+
+// resourceLinodeVolumeCreateClone creates the Volume by cloning source_volume_id, then applies the
+// declared size, tags, and attachment to the clone.
+func resourceLinodeVolumeCreateClone(d *schema.ResourceData, meta interface{}) error {
+	client := getVolumeClient(meta)
+	sourceID := d.Get("source_volume_id").(int)
+	timeout := int(d.Timeout(schema.TimeoutCreate).Seconds())
+
+	volume, err := client.CloneVolume(context.Background(), sourceID, d.Get("label").(string))
+	if err != nil {
+		return fmt.Errorf("Error cloning Linode Volume %d: %s", sourceID, formatLinodeError(err))
+	}
+
+	d.SetId(fmt.Sprintf("%d", volume.ID))
+
+	// The clone has to be active before it can be resized, tagged, or attached
+	active, err := client.WaitForVolumeStatus(context.Background(), volume.ID, linodego.VolumeActive, timeout)
+	if err != nil {
+		return fmt.Errorf("Error waiting for Linode Volume %d to finish cloning: %s", volume.ID, err)
+	}
+	volume = active
+
+	if size := d.Get("size").(int); size > volume.Size {
+		if err := client.ResizeVolume(context.Background(), volume.ID, size); err != nil {
+			return fmt.Errorf("Error resizing Linode Volume %d: %s", volume.ID, err)
+		}
+
+		if _, err := client.WaitForVolumeStatus(
+			context.Background(), volume.ID, linodego.VolumeActive, timeout,
+		); err != nil {
+			return err
+		}
+	}
+
+	if tagsRaw, ok := d.GetOk("tags"); ok {
+		tags := []string{}
+		for _, tag := range tagsRaw.(*schema.Set).List() {
+			tags = append(tags, tag.(string))
+		}
+
+		if _, err := client.UpdateVolume(context.Background(), volume.ID, linodego.VolumeUpdateOptions{
+			Tags: &tags,
+		}); err != nil {
+			return fmt.Errorf("Error tagging Linode Volume %d: %s", volume.ID, err)
+		}
+	}
+
+	if lID, ok := d.GetOk("linode_id"); ok {
+		linodeID := lID.(int)
+		if _, err := client.AttachVolume(context.Background(), volume.ID, &linodego.VolumeAttachOptions{
+			LinodeID: linodeID,
+		}); err != nil {
+			return fmt.Errorf("Error attaching Linode Volume %d to Linode Instance %d: %s", volume.ID, linodeID, err)
+		}
+
+		if _, err := client.WaitForVolumeLinodeID(
+			context.Background(), volume.ID, &linodeID, int(d.Timeout(schema.TimeoutUpdate).Seconds()),
+		); err != nil {
+			return err
+		}
 	}
 
 	return resourceLinodeVolumeRead(d, meta)
 }
 
 func resourceLinodeVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ProviderMeta).Client
+	client := getVolumeClient(meta)
 
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
@@ -183,13 +275,12 @@ func resourceLinodeVolumeUpdate(d *schema.ResourceData, meta interface{}) error
 	updateOpts := linodego.VolumeUpdateOptions{}
 	doUpdate := false
 	if d.HasChange("tags") {
-		tags := []string{}
-		for _, tag := range d.Get("tags").(*schema.Set).List() {
-			tags = append(tags, tag.(string))
+		oldTags, newTags := d.GetChange("tags")
+		err := updateResourceTags(context.Background(), client, entityVolume, volume.ID,
+			expandStringSet(oldTags.(*schema.Set)), expandStringSet(newTags.(*schema.Set)))
+		if err != nil {
+			return err
 		}
-
-		updateOpts.Tags = &tags
-		doUpdate = true
 	}
 
 	if d.HasChange("label") {
@@ -201,7 +292,6 @@ func resourceLinodeVolumeUpdate(d *schema.ResourceData, meta interface{}) error
 		if volume, err = client.UpdateVolume(context.Background(), volume.ID, updateOpts); err != nil {
 			return err
 		}
-		d.Set("tags", volume.Tags)
 		d.Set("label", volume.Label)
 	}
 
@@ -257,7 +347,7 @@ func resourceLinodeVolumeUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceLinodeVolumeDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ProviderMeta).Client
+	client := getVolumeClient(meta)
 	id64, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return fmt.Errorf("Error parsing Linode Volume id %s as int", d.Id())
@@ -295,3 +385,47 @@ func detectVolumeIDChange(have *int, want *int) (changed bool) {
 	}
 	return changed
 }
+
+// resourceLinodeVolumeCustomizeDiff ensures that a cloned Volume stays in the region of its source, as the
+// API does not support cloning or migrating Volumes between regions.
+func resourceLinodeVolumeCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChange("source_volume_id") && !d.HasChange("region") {
+		return nil
+	}
+	if !d.NewValueKnown("source_volume_id") || !d.NewValueKnown("region") {
+		return nil
+	}
+
+	sourceID := d.Get("source_volume_id").(int)
+	if sourceID == 0 {
+		return nil
+	}
+
+	client := meta.(*ProviderMeta).Client
+	source, err := client.GetVolume(ctx, sourceID)
+	if err != nil {
+		return fmt.Errorf("Error getting source Linode Volume %d: %s", sourceID, err)
+	}
+
+	if region := d.Get("region").(string); region != source.Region {
+		return fmt.Errorf("Linode Volume %d is in region %s and cannot be cloned into region %s; "+
+			"Volumes can only be cloned within the same region", sourceID, source.Region, region)
+	}
+
+	if size := d.Get("size").(int); size != 0 && size < source.Size {
+		return fmt.Errorf("size %d is smaller than the %d GB of source Linode Volume %d; Volumes cannot shrink",
+			size, source.Size, sourceID)
+	}
+	return nil
+}
+
+// getVolumeClient returns a copy of the provider's client that polls for Volume events
+// every volume_event_poll_ms, falling back to event_poll_ms when it is unset.
+func getVolumeClient(meta interface{}) linodego.Client {
+	providerMeta := meta.(*ProviderMeta)
+	client := providerMeta.Client
+	if pollMs := providerMeta.Config.VolumeEventPollMilliseconds; pollMs != 0 {
+		client.SetPollDelay(time.Duration(pollMs))
+	}
+	return client
+}
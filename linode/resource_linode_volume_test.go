@@ -3,6 +3,7 @@ package linode
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -86,7 +87,7 @@ func TestAccLinodeVolume_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resName, "region", "us-west"),
 					resource.TestCheckResourceAttr(resName, "linode_id", "0"),
 					resource.TestCheckResourceAttr(resName, "tags.#", "1"),
-					resource.TestCheckResourceAttr(resName, "tags.0", "tf_test"),
+					resource.TestCheckTypeSetElemAttr(resName, "tags.*", "tf_test"),
 				),
 			},
 
@@ -124,8 +125,8 @@ func TestAccLinodeVolume_update(t *testing.T) {
 					testAccCheckLinodeVolumeExists(resName, &volume),
 					resource.TestCheckResourceAttr(resName, "label", fmt.Sprintf("%s_r", volumeName)),
 					resource.TestCheckResourceAttr(resName, "tags.#", "2"),
-					resource.TestCheckResourceAttr(resName, "tags.0", "tf_test"),
-					resource.TestCheckResourceAttr(resName, "tags.1", "tf_test_2"),
+					resource.TestCheckTypeSetElemAttr(resName, "tags.*", "tf_test"),
+					resource.TestCheckTypeSetElemAttr(resName, "tags.*", "tf_test_2"),
 				),
 			},
 		},
@@ -276,6 +277,36 @@ func TestAccLinodeVolume_reattachedBetweenInstances(t *testing.T) {
 	})
 }
 
+func TestAccLinodeVolume_cloned(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_volume.clone"
+	var volumeName = acctest.RandomWithPrefix("tf_test")
+	var volume = linodego.Volume{}
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckLinodeVolumeConfigCloned(volumeName, "us-west"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeVolumeExists(resName, &volume),
+					resource.TestCheckResourceAttrPair(resName, "source_volume_id", "linode_volume.foobar", "id"),
+					resource.TestCheckResourceAttr(resName, "label", volumeName+"_c"),
+					resource.TestCheckResourceAttr(resName, "region", "us-west"),
+					resource.TestCheckResourceAttr(resName, "size", "30"),
+					resource.TestCheckResourceAttr(resName, "tags.#", "1"),
+				),
+			},
+			{
+				Config:      testAccCheckLinodeVolumeConfigCloned(volumeName, "us-east"),
+				ExpectError: regexp.MustCompile(`Volumes can only be cloned within the same region`),
+			},
+		},
+	})
+}
+
 func testAccCheckLinodeVolumeExists(name string, volume *linodego.Volume) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client := testAccProvider.Meta().(*ProviderMeta).Client
@@ -362,6 +393,18 @@ resource "linode_volume" "foobar" {
 }`, volume)
 }
 
+func testAccCheckLinodeVolumeConfigCloned(volume, region string) string {
+	return testAccCheckLinodeVolumeConfigBasic(volume) + fmt.Sprintf(`
+
+resource "linode_volume" "clone" {
+	label = "%s_c"
+	region = "%s"
+	size = 30
+	source_volume_id = linode_volume.foobar.id
+	tags = ["tf_test"]
+}`, volume, region)
+}
+
 func testAccCheckLinodeVolumeConfigAttached(volume string) string {
 	return fmt.Sprintf(`
 resource "linode_instance" "foobar" {